@@ -0,0 +1,21 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewAnteHandler returns an AnteHandler that runs the given decorators, in
+// order, before calling the next AnteHandler. It is used to prepend the Evmos
+// specific checks to the Ethermint AnteHandler.
+func NewAnteHandler(next sdk.AnteHandler, decorators ...sdk.AnteDecorator) sdk.AnteHandler {
+	handler := next
+
+	for i := len(decorators) - 1; i >= 0; i-- {
+		decorator, nextHandler := decorators[i], handler
+		handler = func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return decorator.AnteHandle(ctx, tx, simulate, nextHandler)
+		}
+	}
+
+	return handler
+}
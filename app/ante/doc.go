@@ -0,0 +1,27 @@
+/*
+Package ante defines the SDK auth module's AnteHandler as well as an internal
+AnteHandler for an Ethereum transaction (i.e MsgEthereumTx).
+
+During CheckTx, the transaction is passed through a series of
+pre-message execution validation checks such as signature and account
+verification in addition to minimum fees being checked. Otherwise, during
+DeliverTx, the transaction is simply passed to the EVM which will also
+perform the same series of checks. The distinction is made in CheckTx to
+prevent spam and DoS attacks.
+
+The package is a copy of the app/ante package of the Ethermint version set by
+the replace directive of go.mod. Ethermint links ibc-go v1, whose IBC ante
+decorator and type registrations conflict with ibc-go v3, which provides the
+interchain accounts module and requires Cosmos SDK v0.45. The deviations from
+upstream are that NewAnteHandler takes the ibc-go v3 IBC keeper for the IBC
+ante decorator instead of the v1 channel keeper, and that it runs the Evmos
+decorators that pay the fees of the Ethereum transactions after their
+signature verification, which passes the transaction on instead of its
+message so that these decorators can read its fee.
+
+When the Ethermint version is bumped, `make ante-diff` prints the diff between
+the upstream package and this one, and the upstream changes are ported here
+keeping the deviations above. The package is removed once Ethermint depends on
+ibc-go v3.
+*/
+package ante
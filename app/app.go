@@ -35,7 +35,6 @@ import (
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
@@ -63,9 +62,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
-	"github.com/cosmos/cosmos-sdk/x/mint"
-	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
-	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	paramsclient "github.com/cosmos/cosmos-sdk/x/params/client"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
@@ -82,20 +78,27 @@ import (
 	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
-	"github.com/cosmos/ibc-go/modules/apps/transfer"
-	ibctransferkeeper "github.com/cosmos/ibc-go/modules/apps/transfer/keeper"
-	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
-	ibc "github.com/cosmos/ibc-go/modules/core"
-	ibcclient "github.com/cosmos/ibc-go/modules/core/02-client"
-	ibcclientclient "github.com/cosmos/ibc-go/modules/core/02-client/client"
-	porttypes "github.com/cosmos/ibc-go/modules/core/05-port/types"
-	ibchost "github.com/cosmos/ibc-go/modules/core/24-host"
-	ibckeeper "github.com/cosmos/ibc-go/modules/core/keeper"
+	ica "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts"
+	icacontroller "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icahost "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host"
+	icahostkeeper "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/keeper"
+	icahosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer"
+	ibctransferkeeper "github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	ibc "github.com/cosmos/ibc-go/v3/modules/core"
+	ibcclient "github.com/cosmos/ibc-go/v3/modules/core/02-client"
+	ibcclientclient "github.com/cosmos/ibc-go/v3/modules/core/02-client/client"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibchost "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibckeeper "github.com/cosmos/ibc-go/v3/modules/core/keeper"
 
 	// unnamed import of statik for swagger UI support
 	_ "github.com/tharsis/ethermint/client/docs/statik"
 
-	"github.com/tharsis/ethermint/app/ante"
 	srvflags "github.com/tharsis/ethermint/server/flags"
 	ethermint "github.com/tharsis/ethermint/types"
 	"github.com/tharsis/ethermint/x/evm"
@@ -105,6 +108,56 @@ import (
 	"github.com/tharsis/ethermint/x/feemarket"
 	feemarketkeeper "github.com/tharsis/ethermint/x/feemarket/keeper"
 	feemarkettypes "github.com/tharsis/ethermint/x/feemarket/types"
+
+	"github.com/tharsis/evmos/app/ante"
+	"github.com/tharsis/evmos/x/claims"
+	claimskeeper "github.com/tharsis/evmos/x/claims/keeper"
+	claimstypes "github.com/tharsis/evmos/x/claims/types"
+	"github.com/tharsis/evmos/x/deployment"
+	deploymentkeeper "github.com/tharsis/evmos/x/deployment/keeper"
+	deploymenttypes "github.com/tharsis/evmos/x/deployment/types"
+	"github.com/tharsis/evmos/x/epochs"
+	epochskeeper "github.com/tharsis/evmos/x/epochs/keeper"
+	epochstypes "github.com/tharsis/evmos/x/epochs/types"
+	"github.com/tharsis/evmos/x/erc20"
+	erc20client "github.com/tharsis/evmos/x/erc20/client"
+	erc20keeper "github.com/tharsis/evmos/x/erc20/keeper"
+	erc20types "github.com/tharsis/evmos/x/erc20/types"
+	"github.com/tharsis/evmos/x/evmfeegrant"
+	evmfeegrantkeeper "github.com/tharsis/evmos/x/evmfeegrant/keeper"
+	evmfeegranttypes "github.com/tharsis/evmos/x/evmfeegrant/types"
+	"github.com/tharsis/evmos/x/feeabs"
+	feeabskeeper "github.com/tharsis/evmos/x/feeabs/keeper"
+	feeabstypes "github.com/tharsis/evmos/x/feeabs/types"
+	"github.com/tharsis/evmos/x/feeburn"
+	feeburnkeeper "github.com/tharsis/evmos/x/feeburn/keeper"
+	feeburntypes "github.com/tharsis/evmos/x/feeburn/types"
+	"github.com/tharsis/evmos/x/fees"
+	feeskeeper "github.com/tharsis/evmos/x/fees/keeper"
+	feestypes "github.com/tharsis/evmos/x/fees/types"
+	"github.com/tharsis/evmos/x/incentives"
+	incentivesclient "github.com/tharsis/evmos/x/incentives/client"
+	incentiveskeeper "github.com/tharsis/evmos/x/incentives/keeper"
+	incentivestypes "github.com/tharsis/evmos/x/incentives/types"
+	"github.com/tharsis/evmos/x/inflation"
+	inflationkeeper "github.com/tharsis/evmos/x/inflation/keeper"
+	inflationtypes "github.com/tharsis/evmos/x/inflation/types"
+	"github.com/tharsis/evmos/x/intertx"
+	intertxkeeper "github.com/tharsis/evmos/x/intertx/keeper"
+	intertxtypes "github.com/tharsis/evmos/x/intertx/types"
+	"github.com/tharsis/evmos/x/ratelimit"
+	ratelimitkeeper "github.com/tharsis/evmos/x/ratelimit/keeper"
+	ratelimittypes "github.com/tharsis/evmos/x/ratelimit/types"
+	"github.com/tharsis/evmos/x/recovery"
+	recoverykeeper "github.com/tharsis/evmos/x/recovery/keeper"
+	recoverytypes "github.com/tharsis/evmos/x/recovery/types"
+	"github.com/tharsis/evmos/x/sponsorship"
+	sponsorshipclient "github.com/tharsis/evmos/x/sponsorship/client"
+	sponsorshipkeeper "github.com/tharsis/evmos/x/sponsorship/keeper"
+	sponsorshiptypes "github.com/tharsis/evmos/x/sponsorship/types"
+	"github.com/tharsis/evmos/x/vesting"
+	vestingkeeper "github.com/tharsis/evmos/x/vesting/keeper"
+	vestingtypes "github.com/tharsis/evmos/x/vesting/types"
 )
 
 func init() {
@@ -132,11 +185,14 @@ var (
 		bank.AppModuleBasic{},
 		capability.AppModuleBasic{},
 		staking.AppModuleBasic{},
-		mint.AppModuleBasic{},
 		distr.AppModuleBasic{},
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distrclient.ProposalHandler, upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
 			ibcclientclient.UpdateClientProposalHandler, ibcclientclient.UpgradeProposalHandler,
+			// Evmos proposal types
+			erc20client.RegisterCoinProposalHandler, erc20client.RegisterERC20ProposalHandler, erc20client.ToggleTokenConversionProposalHandler,
+			incentivesclient.RegisterIncentiveProposalHandler, incentivesclient.CancelIncentiveProposalHandler,
+			sponsorshipclient.RegisterSponsorshipProposalHandler, sponsorshipclient.CancelSponsorshipProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -147,26 +203,51 @@ var (
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
 		transfer.AppModuleBasic{},
-		vesting.AppModuleBasic{},
+		ICAAppModuleBasic{},
 		evm.AppModuleBasic{},
 		feemarket.AppModuleBasic{},
+		erc20.AppModuleBasic{},
+		incentives.AppModuleBasic{},
+		fees.AppModuleBasic{},
+		claims.AppModuleBasic{},
+		epochs.AppModuleBasic{},
+		inflation.AppModuleBasic{},
+		vesting.AppModuleBasic{},
+		recovery.AppModuleBasic{},
+		ratelimit.AppModuleBasic{},
+		deployment.AppModuleBasic{},
+		feeabs.AppModuleBasic{},
+		feeburn.AppModuleBasic{},
+		evmfeegrant.AppModuleBasic{},
+		sponsorship.AppModuleBasic{},
+		intertx.AppModuleBasic{},
 	)
 
 	// module account permissions
 	maccPerms = map[string][]string{
 		authtypes.FeeCollectorName:     nil,
 		distrtypes.ModuleName:          nil,
-		minttypes.ModuleName:           {authtypes.Minter},
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+		icatypes.ModuleName:            nil,
 		evmtypes.ModuleName:            {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
+		erc20types.ModuleName:          {authtypes.Minter, authtypes.Burner},
+		incentivestypes.ModuleName:     nil,
+		claimstypes.ModuleName:         nil,
+		inflationtypes.ModuleName:      {authtypes.Minter},
+		feeabstypes.ModuleName:         nil,
+		feeburntypes.ModuleName:        {authtypes.Burner},
+		sponsorshiptypes.ModuleName:    nil,
 	}
 
 	// module accounts that are allowed to receive tokens
 	allowedReceivingModAcc = map[string]bool{
-		distrtypes.ModuleName: true,
+		distrtypes.ModuleName:       true,
+		incentivestypes.ModuleName:  true,
+		feeabstypes.ModuleName:      true,
+		sponsorshiptypes.ModuleName: true,
 	}
 )
 
@@ -198,7 +279,6 @@ type Evmos struct {
 	CapabilityKeeper *capabilitykeeper.Keeper
 	StakingKeeper    stakingkeeper.Keeper
 	SlashingKeeper   slashingkeeper.Keeper
-	MintKeeper       mintkeeper.Keeper
 	DistrKeeper      distrkeeper.Keeper
 	GovKeeper        govkeeper.Keeper
 	CrisisKeeper     crisiskeeper.Keeper
@@ -210,14 +290,38 @@ type Evmos struct {
 	EvidenceKeeper   evidencekeeper.Keeper
 	TransferKeeper   ibctransferkeeper.Keeper
 
+	// ICS-27 interchain accounts keepers
+	ICAControllerKeeper icacontrollerkeeper.Keeper
+	ICAHostKeeper       icahostkeeper.Keeper
+
 	// make scoped keepers public for test purposes
-	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
-	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
+	ScopedIBCKeeper           capabilitykeeper.ScopedKeeper
+	ScopedTransferKeeper      capabilitykeeper.ScopedKeeper
+	ScopedICAControllerKeeper capabilitykeeper.ScopedKeeper
+	ScopedICAHostKeeper       capabilitykeeper.ScopedKeeper
+	ScopedInterTxKeeper       capabilitykeeper.ScopedKeeper
 
 	// Ethermint keepers
 	EvmKeeper       *evmkeeper.Keeper
 	FeeMarketKeeper feemarketkeeper.Keeper
 
+	// Evmos keepers
+	Erc20Keeper       erc20keeper.Keeper
+	IncentivesKeeper  incentiveskeeper.Keeper
+	FeesKeeper        feeskeeper.Keeper
+	ClaimsKeeper      claimskeeper.Keeper
+	EpochsKeeper      epochskeeper.Keeper
+	InflationKeeper   inflationkeeper.Keeper
+	VestingKeeper     vestingkeeper.Keeper
+	RecoveryKeeper    recoverykeeper.Keeper
+	RateLimitKeeper   ratelimitkeeper.Keeper
+	DeploymentKeeper  deploymentkeeper.Keeper
+	FeeAbsKeeper      feeabskeeper.Keeper
+	FeeBurnKeeper     feeburnkeeper.Keeper
+	EvmFeeGrantKeeper evmfeegrantkeeper.Keeper
+	SponsorshipKeeper sponsorshipkeeper.Keeper
+	InterTxKeeper     intertxkeeper.Keeper
+
 	// the module manager
 	mm *module.Manager
 
@@ -226,6 +330,9 @@ type Evmos struct {
 
 	// the configurator
 	configurator module.Configurator
+
+	// the EVM post transaction hooks dispatcher
+	evmHooks *EVMHooks
 }
 
 // NewEvmos returns a reference to a new initialized Ethermint application.
@@ -260,18 +367,25 @@ func NewEvmos(
 	keys := sdk.NewKVStoreKeys(
 		// SDK keys
 		authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey,
-		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
+		distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, upgradetypes.StoreKey,
 		evidencetypes.StoreKey, capabilitytypes.StoreKey,
 		feegrant.StoreKey, authzkeeper.StoreKey,
 		// ibc keys
-		ibchost.StoreKey, ibctransfertypes.StoreKey,
+		ibchost.StoreKey, ibctransfertypes.StoreKey, icacontrollertypes.StoreKey, icahosttypes.StoreKey,
 		// ethermint keys
 		evmtypes.StoreKey, feemarkettypes.StoreKey,
+		// evmos keys
+		erc20types.StoreKey, incentivestypes.StoreKey, feestypes.StoreKey, claimstypes.StoreKey,
+		epochstypes.StoreKey, inflationtypes.StoreKey, ratelimittypes.StoreKey, feeburntypes.StoreKey,
+		sponsorshiptypes.StoreKey,
 	)
 
-	// Add the EVM transient store key
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, evmtypes.TransientKey)
+	// Add the EVM, feeburn, evmfeegrant and sponsorship transient store keys
+	tkeys := sdk.NewTransientStoreKeys(
+		paramstypes.TStoreKey, evmtypes.TransientKey, feeburntypes.TransientKey, evmfeegranttypes.TransientKey,
+		sponsorshiptypes.TransientKey, deploymenttypes.TransientKey,
+	)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	app := &Evmos{
@@ -295,6 +409,9 @@ func NewEvmos(
 
 	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibchost.ModuleName)
 	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
+	scopedICAControllerKeeper := app.CapabilityKeeper.ScopeToModule(icacontrollertypes.SubModuleName)
+	scopedICAHostKeeper := app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
+	scopedInterTxKeeper := app.CapabilityKeeper.ScopeToModule(intertxtypes.ModuleName)
 
 	// Applications that wish to enforce statically created ScopedKeepers should call `Seal` after creating
 	// their scoped modules in `NewApp` with `ScopeToModule`
@@ -310,10 +427,6 @@ func NewEvmos(
 	stakingKeeper := stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName),
 	)
-	app.MintKeeper = mintkeeper.NewKeeper(
-		appCodec, keys[minttypes.StoreKey], app.GetSubspace(minttypes.ModuleName), &stakingKeeper,
-		app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName,
-	)
 	app.DistrKeeper = distrkeeper.NewKeeper(
 		appCodec, keys[distrtypes.StoreKey], app.GetSubspace(distrtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, authtypes.FeeCollectorName, app.ModuleAccountAddrs(),
@@ -327,10 +440,20 @@ func NewEvmos(
 	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, keys[feegrant.StoreKey], app.AccountKeeper)
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath, app.BaseApp)
 
+	app.ClaimsKeeper = claimskeeper.NewKeeper(
+		keys[claimstypes.StoreKey], appCodec, app.GetSubspace(claimstypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper,
+	)
+
+	app.InflationKeeper = inflationkeeper.NewKeeper(
+		keys[inflationtypes.StoreKey], appCodec, app.GetSubspace(inflationtypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, authtypes.FeeCollectorName,
+	)
+
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	app.StakingKeeper = *stakingKeeper.SetHooks(
-		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
+		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks(), app.ClaimsKeeper.Hooks()),
 	)
 
 	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], appCodec, app.BaseApp.MsgServiceRouter())
@@ -343,9 +466,16 @@ func NewEvmos(
 	)
 
 	// Create Ethermint keepers
+	app.DeploymentKeeper = deploymentkeeper.NewKeeper(tkeys[deploymenttypes.TransientKey], app.GetSubspace(deploymenttypes.ModuleName))
+
+	// NOTE: the EVM keeper uses the account keeper wrapped by the deployment
+	// module to record the contracts that create contracts
 	app.EvmKeeper = evmkeeper.NewKeeper(
 		appCodec, keys[evmtypes.StoreKey], tkeys[evmtypes.TransientKey], app.GetSubspace(evmtypes.ModuleName),
-		app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.FeeMarketKeeper,
+		deploymentkeeper.NewEVMAccountKeeper(
+			vestingkeeper.NewEVMAccountKeeper(app.AccountKeeper), app.DeploymentKeeper, tkeys[evmtypes.TransientKey],
+		),
+		app.BankKeeper, app.StakingKeeper, app.FeeMarketKeeper,
 		tracer, bApp.Trace(), // debug EVM based on Baseapp options
 	)
 
@@ -354,13 +484,72 @@ func NewEvmos(
 		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), app.StakingKeeper, app.UpgradeKeeper, scopedIBCKeeper,
 	)
 
+	// Create Evmos keepers
+	app.Erc20Keeper = erc20keeper.NewKeeper(
+		keys[erc20types.StoreKey], appCodec, app.GetSubspace(erc20types.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.EvmKeeper,
+	)
+
+	app.IncentivesKeeper = incentiveskeeper.NewKeeper(
+		keys[incentivestypes.StoreKey], appCodec, app.GetSubspace(incentivestypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper,
+	)
+
+	app.FeesKeeper = feeskeeper.NewKeeper(
+		keys[feestypes.StoreKey], appCodec, app.GetSubspace(feestypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.EvmKeeper, authtypes.FeeCollectorName,
+	)
+
+	app.FeeBurnKeeper = feeburnkeeper.NewKeeper(
+		keys[feeburntypes.StoreKey], tkeys[feeburntypes.TransientKey], app.GetSubspace(feeburntypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, app.FeeMarketKeeper, app.EvmKeeper,
+		authtypes.FeeCollectorName,
+	)
+
+	app.VestingKeeper = vestingkeeper.NewKeeper(
+		appCodec, app.AccountKeeper, app.BankKeeper,
+	)
+
+	app.RecoveryKeeper = recoverykeeper.NewKeeper(
+		app.GetSubspace(recoverytypes.ModuleName), app.AccountKeeper,
+	)
+
+	app.FeeAbsKeeper = feeabskeeper.NewKeeper(
+		app.GetSubspace(feeabstypes.ModuleName), app.BankKeeper, app.EvmKeeper,
+	)
+
+	app.EvmFeeGrantKeeper = evmfeegrantkeeper.NewKeeper(
+		tkeys[evmfeegranttypes.TransientKey], appCodec, app.BankKeeper, app.FeeGrantKeeper, app.EvmKeeper, app.FeeMarketKeeper,
+	)
+
+	app.SponsorshipKeeper = sponsorshipkeeper.NewKeeper(
+		keys[sponsorshiptypes.StoreKey], tkeys[sponsorshiptypes.TransientKey], appCodec,
+		app.GetSubspace(sponsorshiptypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.DistrKeeper,
+		app.EvmKeeper, app.FeeMarketKeeper,
+	)
+
+	epochsKeeper := epochskeeper.NewKeeper(keys[epochstypes.StoreKey], appCodec)
+	app.EpochsKeeper = *epochsKeeper.SetHooks(
+		epochstypes.NewMultiEpochHooks(
+			// insert epoch hooks receivers here
+			// NOTE: the inflation is minted before the distribution of the
+			// incentives so that the usage incentives are available
+			app.InflationKeeper,
+			app.IncentivesKeeper,
+			app.SponsorshipKeeper,
+		),
+	)
+
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
-		AddRoute(ibchost.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
+		AddRoute(ibchost.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
+		AddRoute(erc20types.RouterKey, erc20.NewErc20ProposalHandler(&app.Erc20Keeper)).
+		AddRoute(incentivestypes.RouterKey, incentives.NewIncentivesProposalHandler(&app.IncentivesKeeper)).
+		AddRoute(sponsorshiptypes.RouterKey, sponsorship.NewSponsorshipProposalHandler(&app.SponsorshipKeeper))
 
 	govKeeper := govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
@@ -369,21 +558,88 @@ func NewEvmos(
 
 	app.GovKeeper = *govKeeper.SetHooks(
 		govtypes.NewMultiGovHooks(
-		// register the governance hooks
+			app.ClaimsKeeper.Hooks(),
 		),
 	)
 
+	// NOTE: the ratelimit keeper wraps the IBC channel keeper to limit the
+	// transfers sent by the transfer keeper
+	app.RateLimitKeeper = ratelimitkeeper.NewKeeper(
+		keys[ratelimittypes.StoreKey], appCodec, app.GetSubspace(ratelimittypes.ModuleName),
+		app.IBCKeeper.ChannelKeeper,
+	)
+
 	// Create Transfer Keepers
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
-		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		app.RateLimitKeeper, app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
 		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
 	)
 	transferModule := transfer.NewAppModule(app.TransferKeeper)
-
-	// Create static IBC router, add transfer route, then set and seal it
+	transferIBCModule := transfer.NewIBCModule(app.TransferKeeper)
+
+	// register the EVM post transaction hooks of the Evmos modules, which
+	// convert the ERC20 tokens transferred to the erc20 module, record the gas
+	// spent on the incentivized contracts, distribute the developer fees and
+	// claim the airdrop of the EVM action and check the contracts created by
+	// contracts
+	app.evmHooks = NewEVMHooks().
+		AddHook(erc20types.ModuleName, app.Erc20Keeper).
+		AddHook(incentivestypes.ModuleName, app.IncentivesKeeper).
+		AddHook(feestypes.ModuleName, app.FeesKeeper).
+		AddHook(claimstypes.ModuleName, app.ClaimsKeeper.Hooks()).
+		AddHook(deploymenttypes.ModuleName, app.DeploymentKeeper).
+		AddHook(feeburntypes.ModuleName, app.FeeBurnKeeper)
+
+	// Create the ICS-27 interchain accounts keepers. The controller sends the
+	// transactions of the accounts registered through the intertx module and
+	// the host executes the transactions of the accounts controlled by other
+	// chains, if their messages are allowed by the governance-controlled host
+	// params.
+	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
+		appCodec, keys[icacontrollertypes.StoreKey], app.GetSubspace(icacontrollertypes.SubModuleName),
+		app.IBCKeeper.ChannelKeeper, // may be replaced with middleware such as ics29 fee
+		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		scopedICAControllerKeeper, app.MsgServiceRouter(),
+	)
+	app.ICAHostKeeper = icahostkeeper.NewKeeper(
+		appCodec, keys[icahosttypes.StoreKey], app.GetSubspace(icahosttypes.SubModuleName),
+		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		app.AccountKeeper, scopedICAHostKeeper, app.MsgServiceRouter(),
+	)
+	icaModule := ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper)
+
+	app.InterTxKeeper = intertxkeeper.NewKeeper(appCodec, app.ICAControllerKeeper, scopedInterTxKeeper)
+
+	// interchain accounts controller stack contains (from top to bottom):
+	// - ICS-27 controller
+	// - Intertx authentication module
+	icaControllerStack := icacontroller.NewIBCModule(app.ICAControllerKeeper, intertx.NewIBCModule(app.InterTxKeeper))
+	icaHostIBCModule := icahost.NewIBCModule(app.ICAHostKeeper)
+
+	// transfer stack contains (from top to bottom):
+	// - Claims middleware, to claim the airdrop of the IBC transfer action
+	// - ERC20 middleware, to convert the received vouchers to ERC20 tokens
+	// - Recovery middleware, to refund the transfers sent to unreachable
+	//   addresses
+	// - Rate limit middleware, to cap the net flows of each channel and
+	//   denomination
+	// - Transfer
+	var transferStack porttypes.IBCModule
+	transferStack = ratelimit.NewIBCMiddleware(app.RateLimitKeeper, transferIBCModule)
+	transferStack = recovery.NewIBCMiddleware(app.RecoveryKeeper, transferStack)
+	transferStack = erc20.NewIBCMiddleware(app.Erc20Keeper, transferStack)
+	transferStack = claims.NewIBCMiddleware(app.ClaimsKeeper, transferStack)
+
+	// Create static IBC router, add transfer and interchain accounts routes,
+	// then set and seal it. The intertx route is needed to authenticate the
+	// capability of the channels opened by the controller.
 	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferModule)
+	ibcRouter.
+		AddRoute(ibctransfertypes.ModuleName, transferStack).
+		AddRoute(icacontrollertypes.SubModuleName, icaControllerStack).
+		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
+		AddRoute(intertxtypes.ModuleName, icaControllerStack)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
 	// create evidence keeper with router
@@ -408,12 +664,10 @@ func NewEvmos(
 			encodingConfig.TxConfig,
 		),
 		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
-		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
-		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper),
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
@@ -426,9 +680,27 @@ func NewEvmos(
 		// ibc modules
 		ibc.NewAppModule(app.IBCKeeper),
 		transferModule,
+		icaModule,
 		// Ethermint app modules
-		evm.NewAppModule(app.EvmKeeper, app.AccountKeeper),
+		// NOTE: the evm module is wrapped to call the post transaction hooks
+		// after each successful Ethereum transaction
+		NewEVMAppModule(app.EvmKeeper, app.AccountKeeper, app.FeeMarketKeeper, app.EvmFeeGrantKeeper, app.SponsorshipKeeper, app.evmHooks),
 		feemarket.NewAppModule(app.FeeMarketKeeper),
+		// Evmos app modules
+		erc20.NewAppModule(app.Erc20Keeper, app.AccountKeeper),
+		incentives.NewAppModule(app.IncentivesKeeper, app.AccountKeeper),
+		fees.NewAppModule(app.FeesKeeper),
+		claims.NewAppModule(app.ClaimsKeeper, app.AccountKeeper, app.BankKeeper),
+		epochs.NewAppModule(app.EpochsKeeper),
+		inflation.NewAppModule(app.InflationKeeper, app.AccountKeeper),
+		vesting.NewAppModule(app.VestingKeeper),
+		recovery.NewAppModule(app.RecoveryKeeper),
+		ratelimit.NewAppModule(app.RateLimitKeeper),
+		deployment.NewAppModule(app.DeploymentKeeper),
+		feeabs.NewAppModule(app.FeeAbsKeeper, app.AccountKeeper),
+		feeburn.NewAppModule(app.FeeBurnKeeper),
+		sponsorship.NewAppModule(app.SponsorshipKeeper, app.AccountKeeper),
+		intertx.NewAppModule(app.InterTxKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -437,33 +709,70 @@ func NewEvmos(
 	// NOTE: upgrade module must go first to handle software upgrades.
 	// NOTE: staking module is required if HistoricalEntries param > 0.
 	// NOTE: capability module's beginblocker must come before any modules using capabilities (e.g. IBC)
+	// NOTE: epochs module must go after upgrade module so that the epoch hooks
+	// are called on the migrated state.
 	app.mm.SetOrderBeginBlockers(
 		upgradetypes.ModuleName,
 		capabilitytypes.ModuleName,
+		epochstypes.ModuleName,
 		evmtypes.ModuleName,
-		minttypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
+		distrtypes.ModuleName, slashingtypes.ModuleName,
 		evidencetypes.ModuleName, stakingtypes.ModuleName, ibchost.ModuleName,
+		feeburntypes.ModuleName,
+		// no-op modules
+		ibctransfertypes.ModuleName, icatypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName,
+		govtypes.ModuleName, crisistypes.ModuleName, genutiltypes.ModuleName, authz.ModuleName, feegrant.ModuleName,
+		paramstypes.ModuleName, feemarkettypes.ModuleName, erc20types.ModuleName, incentivestypes.ModuleName,
+		feestypes.ModuleName, claimstypes.ModuleName, inflationtypes.ModuleName, vestingtypes.ModuleName,
+		recoverytypes.ModuleName, ratelimittypes.ModuleName, deploymenttypes.ModuleName, feeabstypes.ModuleName,
+		sponsorshiptypes.ModuleName, intertxtypes.ModuleName,
 	)
 
-	// NOTE: fee market module must go last in order to retrieve the block gas used.
+	// NOTE: fee market module must go last in order to retrieve the block gas used,
+	// only followed by the feeburn module which burns the base fees of the block.
 	app.mm.SetOrderEndBlockers(
 		crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName,
-		evmtypes.ModuleName, feemarkettypes.ModuleName,
+		evmtypes.ModuleName, claimstypes.ModuleName, feemarkettypes.ModuleName,
+		feeburntypes.ModuleName,
+		// no-op modules
+		ibchost.ModuleName, ibctransfertypes.ModuleName, icatypes.ModuleName, capabilitytypes.ModuleName,
+		authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
+		genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName, feegrant.ModuleName,
+		paramstypes.ModuleName, upgradetypes.ModuleName, epochstypes.ModuleName, erc20types.ModuleName,
+		incentivestypes.ModuleName, feestypes.ModuleName, inflationtypes.ModuleName, vestingtypes.ModuleName,
+		recoverytypes.ModuleName, ratelimittypes.ModuleName, deploymenttypes.ModuleName, feeabstypes.ModuleName,
+		sponsorshiptypes.ModuleName, intertxtypes.ModuleName,
+	)
+
+	// NOTE: the EVM post transaction hooks are called in this order and an
+	// error reverts the whole Ethereum transaction
+	app.evmHooks.SetOrderPostTxHooks(
+		erc20types.ModuleName, incentivestypes.ModuleName, feestypes.ModuleName, claimstypes.ModuleName,
+		deploymenttypes.ModuleName, feeburntypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
-	// properly initialized with tokens from genesis accounts.
+	// properly initialized with tokens from genesis accounts, and after the
+	// Evmos modules whose params are read by the AnteHandler that delivers
+	// the genesis transactions.
 	// NOTE: Capability module must occur first so that it can initialize any capabilities
 	// so that other modules that want to create or claim capabilities afterwards in InitChain
 	// can do so safely.
 	app.mm.SetOrderInitGenesis(
 		// SDK modules
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, stakingtypes.ModuleName,
-		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName,
-		ibchost.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, ibctransfertypes.ModuleName,
-		authz.ModuleName, feegrant.ModuleName,
+		slashingtypes.ModuleName, govtypes.ModuleName,
+		ibchost.ModuleName, evidencetypes.ModuleName, ibctransfertypes.ModuleName,
+		icatypes.ModuleName, authz.ModuleName, feegrant.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName,
 		// Ethermint modules
 		evmtypes.ModuleName, feemarkettypes.ModuleName,
+		// Evmos modules
+		epochstypes.ModuleName, erc20types.ModuleName, incentivestypes.ModuleName, feestypes.ModuleName, claimstypes.ModuleName,
+		inflationtypes.ModuleName, recoverytypes.ModuleName, ratelimittypes.ModuleName,
+		deploymenttypes.ModuleName, feeabstypes.ModuleName, feeburntypes.ModuleName, sponsorshiptypes.ModuleName,
+		vestingtypes.ModuleName, intertxtypes.ModuleName,
+
+		genutiltypes.ModuleName,
 
 		// NOTE: crisis module must go at the end to check for invariants on each module
 		crisistypes.ModuleName,
@@ -486,7 +795,6 @@ func NewEvmos(
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
-		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper),
 		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
 		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
@@ -511,17 +819,39 @@ func NewEvmos(
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 
-	// use Ethermint's custom AnteHandler
+	// use Ethermint's custom AnteHandler, preceded by the Evmos decorators
+	// NOTE: the vesting delegation decorator prevents the clawback vesting
+	// accounts from delegating unvested tokens, which couldn't be clawed back,
+	// the deployment decorator restricts the contract creations when the
+	// permissioned deployment is enabled, the fee abstraction decorator allows
+	// paying the fees with the governance-approved fee tokens, the fee grant
+	// decorator pays the fees of the Ethereum transactions with the fee
+	// allowance of their granter and the sponsorship decorator pays the fees of
+	// the calls to the sponsored contracts from their sponsor pool
 	app.SetAnteHandler(
-		ante.NewAnteHandler(
-			app.AccountKeeper, app.BankKeeper, app.EvmKeeper, app.FeeGrantKeeper, app.IBCKeeper.ChannelKeeper,
-			app.FeeMarketKeeper,
-			encodingConfig.TxConfig.SignModeHandler(),
+		NewAnteHandler(
+			ante.NewAnteHandler(
+				app.AccountKeeper, app.BankKeeper, app.EvmKeeper, app.FeeGrantKeeper, app.IBCKeeper,
+				app.FeeMarketKeeper,
+				encodingConfig.TxConfig.SignModeHandler(),
+				// the fees of the Ethereum transactions are paid once their
+				// sender has been authenticated
+				feeabs.NewEthFeeAbstractionDecorator(app.FeeAbsKeeper, app.EvmKeeper, app.FeeMarketKeeper),
+				evmfeegrant.NewFeeGrantDecorator(app.EvmFeeGrantKeeper),
+				sponsorship.NewSponsorshipDecorator(app.SponsorshipKeeper),
+			),
+			vesting.NewDelegationDecorator(app.AccountKeeper),
+			deployment.NewDeploymentDecorator(app.DeploymentKeeper),
+			feeabs.NewFeeAbstractionDecorator(app.FeeAbsKeeper, app.EvmKeeper),
 		),
 	)
 
 	app.SetEndBlocker(app.EndBlocker)
 
+	// NOTE: the store loader must be set before the stores are loaded
+	app.setupUpgradeHandlers(Upgrades)
+	app.setupUpgradeStoreLoaders(Upgrades)
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
@@ -530,6 +860,9 @@ func NewEvmos(
 
 	app.ScopedIBCKeeper = scopedIBCKeeper
 	app.ScopedTransferKeeper = scopedTransferKeeper
+	app.ScopedICAControllerKeeper = scopedICAControllerKeeper
+	app.ScopedICAHostKeeper = scopedICAHostKeeper
+	app.ScopedInterTxKeeper = scopedInterTxKeeper
 
 	return app
 }
@@ -699,15 +1032,28 @@ func initParamsKeeper(
 	paramsKeeper.Subspace(authtypes.ModuleName)
 	paramsKeeper.Subspace(banktypes.ModuleName)
 	paramsKeeper.Subspace(stakingtypes.ModuleName)
-	paramsKeeper.Subspace(minttypes.ModuleName)
 	paramsKeeper.Subspace(distrtypes.ModuleName)
 	paramsKeeper.Subspace(slashingtypes.ModuleName)
 	paramsKeeper.Subspace(govtypes.ModuleName).WithKeyTable(govtypes.ParamKeyTable())
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
+	paramsKeeper.Subspace(icacontrollertypes.SubModuleName)
+	paramsKeeper.Subspace(icahosttypes.SubModuleName)
 	// ethermint subspaces
 	paramsKeeper.Subspace(evmtypes.ModuleName)
 	paramsKeeper.Subspace(feemarkettypes.ModuleName)
+	// evmos subspaces
+	paramsKeeper.Subspace(erc20types.ModuleName)
+	paramsKeeper.Subspace(incentivestypes.ModuleName)
+	paramsKeeper.Subspace(feestypes.ModuleName)
+	paramsKeeper.Subspace(claimstypes.ModuleName)
+	paramsKeeper.Subspace(inflationtypes.ModuleName)
+	paramsKeeper.Subspace(recoverytypes.ModuleName)
+	paramsKeeper.Subspace(ratelimittypes.ModuleName)
+	paramsKeeper.Subspace(deploymenttypes.ModuleName)
+	paramsKeeper.Subspace(feeabstypes.ModuleName)
+	paramsKeeper.Subspace(feeburntypes.ModuleName)
+	paramsKeeper.Subspace(sponsorshiptypes.ModuleName)
 	return paramsKeeper
 }
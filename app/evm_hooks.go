@@ -0,0 +1,237 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tharsis/ethermint/x/evm"
+	evmkeeper "github.com/tharsis/ethermint/x/evm/keeper"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+// EVMPostTxHook defines the interface of the modules that process the result
+// of a successful Ethereum transaction. Contrary to the EVM keeper hooks, it
+// has access to the transaction message, including the sender, and to the
+// receipt, including the gas used and the logs.
+type EVMPostTxHook interface {
+	PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error
+}
+
+// FeeMarketKeeper defines the expected fee market keeper interface used to
+// compute the effective gas price of the transactions.
+type FeeMarketKeeper interface {
+	GetBaseFee(ctx sdk.Context) *big.Int
+}
+
+// EVMFeeGrantKeeper defines the expected interface used to return the fee of
+// the unused gas of the Ethereum transactions paid by a fee grant to their
+// granter.
+type EVMFeeGrantKeeper interface {
+	RefundGrantedFees(ctx sdk.Context, msg *evmtypes.MsgEthereumTx, gasUsed uint64) error
+}
+
+// EVMSponsorshipKeeper defines the expected interface used to return the fee
+// of the unused gas of the sponsored Ethereum transactions to their sponsor
+// pool.
+type EVMSponsorshipKeeper interface {
+	RefundSponsoredFees(ctx sdk.Context, msg *evmtypes.MsgEthereumTx, gasUsed uint64) error
+}
+
+var _ EVMPostTxHook = &EVMHooks{}
+
+// EVMHooks dispatches the result of each successful Ethereum transaction to
+// the post transaction hooks of the subscribed modules. The hooks are called
+// in the order set with SetOrderPostTxHooks, which defaults to the order in
+// which they were added.
+type EVMHooks struct {
+	hooks map[string]EVMPostTxHook
+	order []string
+}
+
+// NewEVMHooks creates a new EVMHooks dispatcher without hooks
+func NewEVMHooks() *EVMHooks {
+	return &EVMHooks{
+		hooks: make(map[string]EVMPostTxHook),
+	}
+}
+
+// AddHook subscribes the post transaction hook of the given module. It panics
+// if the module has already subscribed a hook.
+func (h *EVMHooks) AddHook(moduleName string, hook EVMPostTxHook) *EVMHooks {
+	if _, ok := h.hooks[moduleName]; ok {
+		panic(fmt.Sprintf("EVM post transaction hook of module %s already added", moduleName))
+	}
+
+	h.hooks[moduleName] = hook
+	h.order = append(h.order, moduleName)
+	return h
+}
+
+// SetOrderPostTxHooks sets the order in which the hooks are called. Every
+// added hook must be ordered exactly once.
+func (h *EVMHooks) SetOrderPostTxHooks(moduleNames ...string) {
+	if len(moduleNames) != len(h.hooks) {
+		panic(fmt.Sprintf("%d EVM post transaction hooks ordered, expected %d", len(moduleNames), len(h.hooks)))
+	}
+
+	seen := make(map[string]bool)
+	for _, moduleName := range moduleNames {
+		if _, ok := h.hooks[moduleName]; !ok {
+			panic(fmt.Sprintf("EVM post transaction hook of module %s not added", moduleName))
+		}
+
+		if seen[moduleName] {
+			panic(fmt.Sprintf("EVM post transaction hook of module %s ordered twice", moduleName))
+		}
+
+		seen[moduleName] = true
+	}
+
+	h.order = moduleNames
+}
+
+// PostTxProcessing delegates the call to the hooks in order, returning on the
+// first error
+func (h *EVMHooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	for _, moduleName := range h.order {
+		if err := h.hooks[moduleName].PostTxProcessing(ctx, msg, receipt); err != nil {
+			return sdkerrors.Wrapf(err, "EVM hook of module %s failed", moduleName)
+		}
+	}
+	return nil
+}
+
+var _ evmtypes.MsgServer = evmHooksMsgServer{}
+
+// evmHooksMsgServer wraps the EVM keeper message server to call the post
+// transaction hook after each successful Ethereum transaction.
+type evmHooksMsgServer struct {
+	keeper            *evmkeeper.Keeper
+	feeMarketKeeper   FeeMarketKeeper
+	feeGrantKeeper    EVMFeeGrantKeeper
+	sponsorshipKeeper EVMSponsorshipKeeper
+	hook              EVMPostTxHook
+}
+
+// EthereumTx executes the Ethereum transaction and calls the post transaction
+// hook if it succeeded. An error returned by the hook reverts the whole
+// transaction.
+func (s evmHooksMsgServer) EthereumTx(goCtx context.Context, msg *evmtypes.MsgEthereumTx) (*evmtypes.MsgEthereumTxResponse, error) {
+	res, err := s.keeper.EthereumTx(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the EVM refunds the unused gas to the sender even if the transaction
+	// failed, so the granted or sponsored fee of that gas is returned to the
+	// granter or the sponsor pool
+	if err := s.feeGrantKeeper.RefundGrantedFees(ctx, msg, res.GasUsed); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to refund the granted fees")
+	}
+
+	if err := s.sponsorshipKeeper.RefundSponsoredFees(ctx, msg, res.GasUsed); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to refund the sponsored fees")
+	}
+
+	if res.Failed() {
+		return res, nil
+	}
+
+	tx := msg.AsTransaction()
+
+	// build the message with the same chain rules as the state transition so
+	// that the gas price is the effective one paid by the sender
+	ethCfg := s.keeper.GetParams(ctx).ChainConfig.EthereumConfig(s.keeper.ChainID())
+	signer := ethtypes.MakeSigner(ethCfg, big.NewInt(ctx.BlockHeight()))
+
+	var baseFee *big.Int
+	if evmtypes.IsLondon(ethCfg, ctx.BlockHeight()) {
+		baseFee = s.feeMarketKeeper.GetBaseFee(ctx)
+	}
+
+	coreMsg, err := tx.AsMessage(signer, baseFee)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to return ethereum transaction as core message")
+	}
+
+	from := coreMsg.From()
+
+	receipt := &ethtypes.Receipt{
+		Type:              tx.Type(),
+		Status:            ethtypes.ReceiptStatusSuccessful,
+		CumulativeGasUsed: res.GasUsed,
+		Logs:              evmtypes.LogsToEthereum(res.Logs),
+		TxHash:            common.HexToHash(res.Hash),
+		GasUsed:           res.GasUsed,
+		BlockNumber:       big.NewInt(ctx.BlockHeight()),
+	}
+
+	if tx.To() == nil {
+		receipt.ContractAddress = crypto.CreateAddress(from, tx.Nonce())
+	}
+
+	if err := s.hook.PostTxProcessing(ctx, coreMsg, receipt); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to execute post transaction processing")
+	}
+
+	return res, nil
+}
+
+// EVMAppModule wraps the EVM AppModule to route the Ethereum transactions
+// through the post transaction hook. The EVM keeper hooks only receive the
+// transaction hash and the logs, so the hooks are called by the legacy route
+// of the module instead. NOTE: the SDK routes the messages with a Msg service
+// before the legacy routes, so the hooks are bypassed if the EVM module
+// registers a Msg service, which TestEVMHooksRoute checks.
+type EVMAppModule struct {
+	evm.AppModule
+	keeper            *evmkeeper.Keeper
+	feeMarketKeeper   FeeMarketKeeper
+	feeGrantKeeper    EVMFeeGrantKeeper
+	sponsorshipKeeper EVMSponsorshipKeeper
+	hook              EVMPostTxHook
+}
+
+// NewEVMAppModule creates a new EVMAppModule that calls the given hook after
+// each successful Ethereum transaction and returns the fee of the unused gas
+// of the granted and sponsored transactions to their granter and sponsor pool.
+func NewEVMAppModule(
+	k *evmkeeper.Keeper,
+	ak evmtypes.AccountKeeper,
+	fmk FeeMarketKeeper,
+	fgk EVMFeeGrantKeeper,
+	sk EVMSponsorshipKeeper,
+	hook EVMPostTxHook,
+) EVMAppModule {
+	return EVMAppModule{
+		AppModule:         evm.NewAppModule(k, ak),
+		keeper:            k,
+		feeMarketKeeper:   fmk,
+		feeGrantKeeper:    fgk,
+		sponsorshipKeeper: sk,
+		hook:              hook,
+	}
+}
+
+// Route returns the message routing key for the evm module, using the hooked
+// message server.
+func (am EVMAppModule) Route() sdk.Route {
+	return sdk.NewRoute(evmtypes.RouterKey, evm.NewHandler(evmHooksMsgServer{
+		keeper:            am.keeper,
+		feeMarketKeeper:   am.feeMarketKeeper,
+		feeGrantKeeper:    am.feeGrantKeeper,
+		sponsorshipKeeper: am.sponsorshipKeeper,
+		hook:              am.hook,
+	}))
+}
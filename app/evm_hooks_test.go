@@ -0,0 +1,110 @@
+package app
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+	"github.com/tharsis/ethermint/tests"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	erc20types "github.com/tharsis/evmos/x/erc20/types"
+)
+
+type recordHook struct {
+	name  string
+	calls *[]string
+	err   error
+}
+
+func (h recordHook) PostTxProcessing(_ sdk.Context, _ core.Message, _ *ethtypes.Receipt) error {
+	*h.calls = append(*h.calls, h.name)
+	return h.err
+}
+
+func TestEVMHooks(t *testing.T) {
+	var calls []string
+	hooks := NewEVMHooks().
+		AddHook("a", recordHook{name: "a", calls: &calls}).
+		AddHook("b", recordHook{name: "b", calls: &calls}).
+		AddHook("c", recordHook{name: "c", calls: &calls})
+
+	// defaults to the order in which the hooks were added
+	require.NoError(t, hooks.PostTxProcessing(sdk.Context{}, nil, &ethtypes.Receipt{}))
+	require.Equal(t, []string{"a", "b", "c"}, calls)
+
+	calls = nil
+	hooks.SetOrderPostTxHooks("c", "a", "b")
+	require.NoError(t, hooks.PostTxProcessing(sdk.Context{}, nil, &ethtypes.Receipt{}))
+	require.Equal(t, []string{"c", "a", "b"}, calls)
+
+	require.Panics(t, func() { hooks.AddHook("a", recordHook{name: "a", calls: &calls}) })
+	require.Panics(t, func() { hooks.SetOrderPostTxHooks("a", "b") })
+	require.Panics(t, func() { hooks.SetOrderPostTxHooks("a", "b", "b") })
+	require.Panics(t, func() { hooks.SetOrderPostTxHooks("a", "b", "d") })
+
+	// an error stops the dispatch
+	calls = nil
+	hooks = NewEVMHooks().
+		AddHook("a", recordHook{name: "a", calls: &calls, err: errors.New("failed")}).
+		AddHook("b", recordHook{name: "b", calls: &calls})
+	require.Error(t, hooks.PostTxProcessing(sdk.Context{}, nil, &ethtypes.Receipt{}))
+	require.Equal(t, []string{"a"}, calls)
+}
+
+// TestEVMHooksRoute checks that the Ethereum transactions delivered to the app
+// are executed by the hooked message server of EVMAppModule. The SDK routes the
+// messages through the msg service router first, so the hooks are bypassed if
+// the EVM module registers a Msg service.
+func TestEVMHooksRoute(t *testing.T) {
+	app := Setup(false, nil)
+	require.Nil(t, app.MsgServiceRouter().Handler(&evmtypes.MsgEthereumTx{}))
+
+	var calls []string
+	app.evmHooks.AddHook("test", recordHook{name: "test", calls: &calls})
+
+	consPriv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	header := tmproto.Header{
+		Height:          1,
+		ChainID:         "evmos_9000-1",
+		Time:            time.Now().UTC(),
+		ProposerAddress: consPriv.PubKey().Address(),
+	}
+	ctx := app.BaseApp.NewContext(false, header)
+
+	// the EVM requires the block proposer to be a validator
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(tests.GenerateAddress().Bytes()), consPriv.PubKey(), stakingtypes.Description{})
+	require.NoError(t, err)
+	require.NoError(t, app.StakingKeeper.SetValidatorByConsAddr(ctx, validator))
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	sender, privKey := tests.NewAddrKey()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(app.EvmKeeper.GetParams(ctx).EvmDenom, 1000000000000))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, erc20types.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, erc20types.ModuleName, sender.Bytes(), coins))
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	to := tests.GenerateAddress()
+	chainID := app.EvmKeeper.ChainID()
+	msg := evmtypes.NewTx(chainID, 0, &to, big.NewInt(1), 21000, big.NewInt(1), nil, nil, nil, nil)
+	msg.From = sender.Hex()
+	require.NoError(t, msg.Sign(ethtypes.LatestSignerForChainID(chainID), tests.NewSigner(privKey)))
+
+	res := DeliverEthTx(app, msg)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []string{"test"}, calls)
+}
@@ -0,0 +1,53 @@
+package app
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	ica "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts"
+	icahosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+
+	erc20types "github.com/tharsis/evmos/x/erc20/types"
+)
+
+// DefaultICAHostAllowMessages are the messages that the interchain accounts
+// hosted on Evmos are allowed to execute by default. The list is stored in
+// the ICS-27 host params and can be changed by governance. The host executes
+// the messages through the msg service router, so MsgEthereumTx, which is only
+// routed through the legacy router and carries its own signature, nonce and
+// fees, can't be executed by an interchain account.
+var DefaultICAHostAllowMessages = []string{
+	sdk.MsgTypeURL(&banktypes.MsgSend{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}),
+	sdk.MsgTypeURL(&distrtypes.MsgWithdrawDelegatorReward{}),
+	sdk.MsgTypeURL(&distrtypes.MsgSetWithdrawAddress{}),
+	sdk.MsgTypeURL(&govtypes.MsgVote{}),
+	sdk.MsgTypeURL(&ibctransfertypes.MsgTransfer{}),
+	sdk.MsgTypeURL(&erc20types.MsgConvertCoin{}),
+	sdk.MsgTypeURL(&erc20types.MsgConvertERC20{}),
+}
+
+// ICAAppModuleBasic wraps the interchain accounts AppModuleBasic to allow the
+// hosted accounts to execute the DefaultICAHostAllowMessages in the default
+// genesis state.
+type ICAAppModuleBasic struct {
+	ica.AppModuleBasic
+}
+
+// DefaultGenesis returns the default genesis state of the interchain accounts
+// module, with the Evmos host allow list.
+func (ICAAppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	genesis := icatypes.DefaultGenesis()
+	genesis.HostGenesisState.Params = icahosttypes.NewParams(icahosttypes.DefaultHostEnabled, DefaultICAHostAllowMessages)
+	return cdc.MustMarshalJSON(genesis)
+}
@@ -0,0 +1,48 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icacontrollertypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icahosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	intertxtypes "github.com/tharsis/evmos/x/intertx/types"
+)
+
+func TestICAHostAllowMessages(t *testing.T) {
+	app := Setup(false, nil)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{ChainID: "evmos_9000-1"})
+
+	params := app.ICAHostKeeper.GetParams(ctx)
+	require.True(t, params.HostEnabled)
+	require.Equal(t, DefaultICAHostAllowMessages, params.AllowMessages)
+
+	// the host executes the messages through the msg service router
+	for _, typeURL := range DefaultICAHostAllowMessages {
+		require.NotNil(t, app.MsgServiceRouter().HandlerByTypeURL(typeURL), typeURL)
+	}
+	require.Nil(t, app.MsgServiceRouter().HandlerByTypeURL(sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})))
+}
+
+func TestICAControllerWiring(t *testing.T) {
+	app := Setup(false, nil)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{ChainID: "evmos_9000-1"})
+
+	require.True(t, app.ICAControllerKeeper.GetParams(ctx).ControllerEnabled)
+
+	for _, route := range []string{icacontrollertypes.SubModuleName, icahosttypes.SubModuleName, intertxtypes.ModuleName} {
+		require.True(t, app.IBCKeeper.Router.HasRoute(route), route)
+	}
+
+	for _, msg := range []sdk.Msg{&intertxtypes.MsgRegisterAccount{}, &intertxtypes.MsgSubmitTx{}} {
+		require.NotNil(t, app.MsgServiceRouter().Handler(msg), sdk.MsgTypeURL(msg))
+	}
+}
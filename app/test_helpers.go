@@ -4,7 +4,10 @@ import (
 	"encoding/json"
 	"time"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	"github.com/tharsis/ethermint/encoding"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -12,6 +15,7 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 	feemarkettypes "github.com/tharsis/ethermint/x/feemarket/types"
 )
 
@@ -69,3 +73,41 @@ func Setup(isCheckTx bool, feemarketGenesis *feemarkettypes.GenesisState) *Evmos
 
 	return app
 }
+
+// DeliverEthTx delivers the signed Ethereum transaction in the current block,
+// through the AnteHandler and the message router of the app. The transaction
+// fee is set to the fee cap of the transaction in the EVM denomination.
+func DeliverEthTx(app *Evmos, msg *evmtypes.MsgEthereumTx) abci.ResponseDeliverTx {
+	txConfig := encoding.MakeConfig(ModuleBasics).TxConfig
+	builder, ok := txConfig.NewTxBuilder().(authtx.ExtensionOptionsTxBuilder)
+	if !ok {
+		panic("unsupported tx builder")
+	}
+
+	option, err := codectypes.NewAnyWithValue(&evmtypes.ExtensionOptionsEthereumTx{})
+	if err != nil {
+		panic(err)
+	}
+
+	txData, err := evmtypes.UnpackTxData(msg.Data)
+	if err != nil {
+		panic(err)
+	}
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	evmDenom := app.EvmKeeper.GetParams(ctx).EvmDenom
+
+	builder.SetExtensionOptions(option)
+	if err := builder.SetMsgs(msg); err != nil {
+		panic(err)
+	}
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(evmDenom, sdk.NewIntFromBigInt(txData.Fee()))))
+	builder.SetGasLimit(msg.GetGas())
+
+	bz, err := txConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		panic(err)
+	}
+
+	return app.BaseApp.DeliverTx(abci.RequestDeliverTx{Tx: bz})
+}
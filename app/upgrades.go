@@ -0,0 +1,51 @@
+package app
+
+import (
+	"fmt"
+
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/tharsis/evmos/app/upgrades"
+)
+
+// Upgrades defines the software upgrades handled by the app. New upgrades must
+// be appended with a unique name, matching the plan name of the software
+// upgrade proposal.
+var Upgrades = []upgrades.Upgrade{}
+
+// setupUpgradeHandlers registers the handlers of the given upgrades, which
+// are executed by the upgrade module at the upgrade height.
+func (app *Evmos) setupUpgradeHandlers(upgrades []upgrades.Upgrade) {
+	for _, upgrade := range upgrades {
+		if app.UpgradeKeeper.HasHandler(upgrade.Name) {
+			panic(fmt.Sprintf("duplicated upgrade handler %s", upgrade.Name))
+		}
+
+		app.UpgradeKeeper.SetUpgradeHandler(
+			upgrade.Name,
+			upgrade.CreateUpgradeHandler(app.mm, app.configurator),
+		)
+	}
+}
+
+// setupUpgradeStoreLoaders sets the store loader of the pending upgrade, read
+// from the upgrade-info.json file written by the previous binary when it
+// halted at the upgrade height. The stores of the upgrade are added, renamed
+// and deleted when the store is loaded.
+func (app *Evmos) setupUpgradeStoreLoaders(upgrades []upgrades.Upgrade) {
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Sprintf("failed to read upgrade info from disk: %s", err))
+	}
+
+	if upgradeInfo.Name == "" || app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	for i := range upgrades {
+		if upgrades[i].Name == upgradeInfo.Name {
+			app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &upgrades[i].StoreUpgrades))
+			return
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package upgrades
+
+import (
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// Upgrade defines a named software upgrade of the app. The name must match the
+// plan name of the software upgrade proposal.
+type Upgrade struct {
+	// Name of the upgrade plan
+	Name string
+	// CreateUpgradeHandler returns the handler executed at the upgrade height,
+	// which migrates the state of the modules
+	CreateUpgradeHandler func(mm *module.Manager, configurator module.Configurator) upgradetypes.UpgradeHandler
+	// StoreUpgrades defines the stores added, renamed and deleted by the
+	// upgrade
+	StoreUpgrades store.StoreUpgrades
+}
+
+// CreateDefaultUpgradeHandler returns an upgrade handler that only runs the
+// in-place store migrations of the modules whose consensus version has been
+// bumped.
+func CreateDefaultUpgradeHandler(mm *module.Manager, configurator module.Configurator) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return mm.RunMigrations(ctx, configurator, vm)
+	}
+}
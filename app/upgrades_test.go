@@ -0,0 +1,33 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/tharsis/evmos/app/upgrades"
+)
+
+func TestSetupUpgradeHandlers(t *testing.T) {
+	app := Setup(false, nil)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10, ChainID: "evmos_9000-1", Time: time.Now().UTC()})
+
+	testUpgrades := []upgrades.Upgrade{
+		{Name: "v2", CreateUpgradeHandler: upgrades.CreateDefaultUpgradeHandler},
+	}
+
+	app.setupUpgradeHandlers(testUpgrades)
+	require.True(t, app.UpgradeKeeper.HasHandler("v2"))
+
+	// the migrations run without bumped consensus versions keep the version map
+	plan := upgradetypes.Plan{Name: "v2", Height: 10}
+	require.NotPanics(t, func() { app.UpgradeKeeper.ApplyUpgrade(ctx, plan) })
+	require.Equal(t, app.mm.GetVersionMap(), app.UpgradeKeeper.GetModuleVersionMap(ctx))
+
+	require.Panics(t, func() { app.setupUpgradeHandlers(testUpgrades) })
+}
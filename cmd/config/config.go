@@ -1,39 +1,181 @@
 package types
 
 import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	ethermint "github.com/tharsis/ethermint/types"
 )
 
-const (
+// EnvChainIdentity defines the environment variable of the chain identity file
+// path, which takes precedence over ChainIdentityFile.
+const EnvChainIdentity = "EVMOS_CHAIN_IDENTITY"
+
+// Default chain identity, which can be overridden at build time with ldflags,
+// e.g. -X github.com/tharsis/evmos/cmd/config.Bech32Prefix=mychain
+var (
 	// Bech32Prefix defines the Bech32 prefix used for EthAccounts
 	Bech32Prefix = "evmos"
-
-	// Bech32PrefixAccAddr defines the Bech32 prefix of an account's address
-	Bech32PrefixAccAddr = Bech32Prefix
-	// Bech32PrefixAccPub defines the Bech32 prefix of an account's public key
-	Bech32PrefixAccPub = Bech32Prefix + sdk.PrefixPublic
-	// Bech32PrefixValAddr defines the Bech32 prefix of a validator's operator address
-	Bech32PrefixValAddr = Bech32Prefix + sdk.PrefixValidator + sdk.PrefixOperator
-	// Bech32PrefixValPub defines the Bech32 prefix of a validator's operator public key
-	Bech32PrefixValPub = Bech32Prefix + sdk.PrefixValidator + sdk.PrefixOperator + sdk.PrefixPublic
-	// Bech32PrefixConsAddr defines the Bech32 prefix of a consensus node address
-	Bech32PrefixConsAddr = Bech32Prefix + sdk.PrefixValidator + sdk.PrefixConsensus
-	// Bech32PrefixConsPub defines the Bech32 prefix of a consensus node public key
-	Bech32PrefixConsPub = Bech32Prefix + sdk.PrefixValidator + sdk.PrefixConsensus + sdk.PrefixPublic
-)
-
-const (
+	// BaseDenom defines the base denomination of the chain, used for the fees,
+	// staking, governance and EVM
+	BaseDenom = ethermint.AttoPhoton
 	// DisplayDenom defines the denomination displayed to users in client applications.
 	DisplayDenom = "photon"
+	// DenomExponent defines the exponent of the display denomination, which is
+	// worth 10^DenomExponent base denominations
+	DenomExponent = "18"
+	// MinGasPrice defines the default minimum gas price, in base denomination,
+	// of the app.toml configuration
+	MinGasPrice = "0"
+	// ChainIdentityFile defines the path of the JSON file that overrides the
+	// default chain identity, if not empty
+	ChainIdentityFile = ""
 )
 
+// ChainIdentity defines the address prefix and the denominations of the chain,
+// e.g.
+//
+//	{
+//	  "bech32_prefix": "evmos",
+//	  "base_denom": "aphoton",
+//	  "display_denom": "photon",
+//	  "denom_exponent": 18,
+//	  "min_gas_price": "0"
+//	}
+type ChainIdentity struct {
+	Bech32Prefix  string `json:"bech32_prefix"`
+	BaseDenom     string `json:"base_denom"`
+	DisplayDenom  string `json:"display_denom"`
+	DenomExponent uint32 `json:"denom_exponent"`
+	MinGasPrice   string `json:"min_gas_price"`
+}
+
+// Identity is the chain identity of the running binary, set by
+// LoadChainIdentity.
+var Identity = DefaultChainIdentity()
+
+// DefaultChainIdentity returns the chain identity set at build time. It panics
+// if the exponent set with ldflags isn't a valid number.
+func DefaultChainIdentity() ChainIdentity {
+	exponent, err := strconv.ParseUint(DenomExponent, 10, 32)
+	if err != nil {
+		panic(fmt.Errorf("invalid denom exponent %q: %w", DenomExponent, err))
+	}
+
+	return ChainIdentity{
+		Bech32Prefix:  Bech32Prefix,
+		BaseDenom:     BaseDenom,
+		DisplayDenom:  DisplayDenom,
+		DenomExponent: uint32(exponent),
+		MinGasPrice:   MinGasPrice,
+	}
+}
+
+// LoadChainIdentity sets the chain identity from the file of the
+// EVMOS_CHAIN_IDENTITY environment variable or of ChainIdentityFile, where the
+// omitted fields default to the chain identity set at build time. It must be
+// called before the SDK config is sealed.
+func LoadChainIdentity() error {
+	identity := DefaultChainIdentity()
+
+	path := ChainIdentityFile
+	if envPath := os.Getenv(EnvChainIdentity); envPath != "" {
+		path = envPath
+	}
+
+	if path != "" {
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		if err := json.Unmarshal(contents, &identity); err != nil {
+			return fmt.Errorf("failed to parse chain identity file %s: %w", path, err)
+		}
+	}
+
+	if err := identity.Validate(); err != nil {
+		return err
+	}
+
+	Identity = identity
+	return nil
+}
+
+// Validate performs a basic validation of the chain identity.
+func (ci ChainIdentity) Validate() error {
+	if strings.TrimSpace(ci.Bech32Prefix) == "" {
+		return fmt.Errorf("bech32 prefix cannot be blank")
+	}
+
+	if ci.BaseDenom == ci.DisplayDenom {
+		return fmt.Errorf("base denom and display denom must be different, got %s", ci.BaseDenom)
+	}
+
+	if ci.DenomExponent == 0 || ci.DenomExponent > sdk.Precision {
+		return fmt.Errorf("denom exponent must be between 1 and %d, got %d", sdk.Precision, ci.DenomExponent)
+	}
+
+	minGasPrice, err := sdk.NewDecFromStr(ci.MinGasPrice)
+	if err != nil {
+		return fmt.Errorf("invalid min gas price %q: %w", ci.MinGasPrice, err)
+	}
+
+	if minGasPrice.IsNegative() {
+		return fmt.Errorf("min gas price cannot be negative: %s", minGasPrice)
+	}
+
+	return ci.DenomMetadata().Validate()
+}
+
+// MinGasPrices returns the default minimum gas prices of the app.toml
+// configuration.
+func (ci ChainIdentity) MinGasPrices() string {
+	return ci.MinGasPrice + ci.BaseDenom
+}
+
+// PowerReduction returns the amount of base denominations worth one display
+// denomination.
+func (ci ChainIdentity) PowerReduction() sdk.Int {
+	return sdk.NewIntWithDecimal(1, int(ci.DenomExponent))
+}
+
+// DenomMetadata returns the bank metadata of the base and display
+// denominations.
+func (ci ChainIdentity) DenomMetadata() banktypes.Metadata {
+	return banktypes.Metadata{
+		Description: fmt.Sprintf("The native token of the chain, with base denomination %s", ci.BaseDenom),
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: ci.BaseDenom, Exponent: 0},
+			{Denom: ci.DisplayDenom, Exponent: ci.DenomExponent},
+		},
+		Base:    ci.BaseDenom,
+		Display: ci.DisplayDenom,
+		Name:    ci.DisplayDenom,
+		Symbol:  strings.ToUpper(ci.DisplayDenom),
+	}
+}
+
 // SetBech32Prefixes sets the global prefixes to be used when serializing addresses and public keys to Bech32 strings.
 func SetBech32Prefixes(config *sdk.Config) {
-	config.SetBech32PrefixForAccount(Bech32PrefixAccAddr, Bech32PrefixAccPub)
-	config.SetBech32PrefixForValidator(Bech32PrefixValAddr, Bech32PrefixValPub)
-	config.SetBech32PrefixForConsensusNode(Bech32PrefixConsAddr, Bech32PrefixConsPub)
+	prefix := Identity.Bech32Prefix
+
+	config.SetBech32PrefixForAccount(prefix, prefix+sdk.PrefixPublic)
+	config.SetBech32PrefixForValidator(
+		prefix+sdk.PrefixValidator+sdk.PrefixOperator,
+		prefix+sdk.PrefixValidator+sdk.PrefixOperator+sdk.PrefixPublic,
+	)
+	config.SetBech32PrefixForConsensusNode(
+		prefix+sdk.PrefixValidator+sdk.PrefixConsensus,
+		prefix+sdk.PrefixValidator+sdk.PrefixConsensus+sdk.PrefixPublic,
+	)
 }
 
 // SetBip44CoinType sets the global coin type to be used in hierarchical deterministic wallets.
@@ -45,12 +187,11 @@ func SetBip44CoinType(config *sdk.Config) {
 
 // RegisterDenoms registers the base and display denominations to the SDK.
 func RegisterDenoms() {
-	// TODO: rename
-	if err := sdk.RegisterDenom(DisplayDenom, sdk.OneDec()); err != nil {
+	if err := sdk.RegisterDenom(Identity.DisplayDenom, sdk.OneDec()); err != nil {
 		panic(err)
 	}
 
-	if err := sdk.RegisterDenom(ethermint.AttoPhoton, sdk.NewDecWithPrec(1, ethermint.BaseDenomUnit)); err != nil {
+	if err := sdk.RegisterDenom(Identity.BaseDenom, sdk.NewDecWithPrec(1, int64(Identity.DenomExponent))); err != nil {
 		panic(err)
 	}
 }
@@ -0,0 +1,399 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	vestingcli "github.com/tharsis/evmos/x/vesting/client/cli"
+)
+
+// genesisAccountRow defines an account to be added to the genesis file, as
+// read from a row of a bulk import file
+type genesisAccountRow struct {
+	Address        string                   `json:"address"`
+	Coins          string                   `json:"coins"`
+	Funder         string                   `json:"funder,omitempty"`
+	StartTime      int64                    `json:"start_time,omitempty"`
+	LockupPeriods  []vestingcli.InputPeriod `json:"lockup_periods,omitempty"`
+	VestingPeriods []vestingcli.InputPeriod `json:"vesting_periods,omitempty"`
+
+	// line of the row in the import file
+	line int
+	// error encountered while reading the row
+	err error
+}
+
+// AddGenesisAccountsCmd returns add-genesis-accounts cobra Command.
+func AddGenesisAccountsCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-accounts [file]",
+		Short: "Add the genesis accounts of a CSV or JSON file to genesis.json",
+		Long: `Add the genesis accounts listed in a CSV or JSON file to genesis.json in a single
+pass. Each account specifies its bech32 or hex address, its initial coins and optionally
+the lockup and vesting schedules of a clawback vesting account. The funder of the vesting
+accounts defaults to the --funder flag. Without a funder, only a vesting schedule may be
+given and a periodic vesting account is created instead. All the rows are validated before the genesis file
+is updated and the invalid rows, the duplicated rows and the rows of existing accounts or
+balances are reported along with their line number.
+
+The CSV files have the following columns, where the first row may be a header and the
+schedule periods are written as length_seconds:coins pairs separated by semicolons:
+
+address,coins,funder,start_time,lockup_periods,vesting_periods
+0x7cB61D4117AE31a12E393a1Cfa3BaC666481D02E,"100aphoton,10ufoo"
+evmos1...,200aphoton,evmos1...,1625204910,,2592000:100aphoton;2592000:100aphoton
+
+The JSON files contain a list of accounts:
+[
+  {
+    "address": "evmos1...",
+    "coins": "200aphoton",
+    "funder": "evmos1...",
+    "start_time": 1625204910,
+    "vesting_periods": [
+      { "coins": "100aphoton", "length_seconds": 2592000 },
+      { "coins": "100aphoton", "length_seconds": 2592000 }
+    ]
+  }
+]
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			funder, err := cmd.Flags().GetString(flagFunder)
+			if err != nil {
+				return err
+			}
+
+			rows, err := readGenesisAccountRows(args[0])
+			if err != nil {
+				return err
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			authGenState := authtypes.GetGenesisStateFromAppState(clientCtx.Codec, appState)
+
+			accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+			if err != nil {
+				return fmt.Errorf("failed to get accounts from any: %w", err)
+			}
+
+			bankGenState := banktypes.GetGenesisStateFromAppState(clientCtx.Codec, appState)
+
+			var (
+				genAccounts      authtypes.GenesisAccounts
+				balances         []banktypes.Balance
+				invalidRows      []string
+				seenAccounts     = make(map[string]int)
+				existingAccounts = genesisAccountAddresses(accs)
+				existingBalances = genesisBalanceAddresses(bankGenState.Balances)
+			)
+
+			// validate all the rows before updating the genesis file
+			for _, row := range rows {
+				if row.err != nil {
+					invalidRows = append(invalidRows, fmt.Sprintf("line %d: %s", row.line, row.err))
+					continue
+				}
+
+				genAccount, coins, err := row.toGenesisAccount(funder)
+				if err != nil {
+					invalidRows = append(invalidRows, fmt.Sprintf("line %d: %s", row.line, err))
+					continue
+				}
+
+				addr := genAccount.GetAddress()
+				if line, ok := seenAccounts[addr.String()]; ok {
+					invalidRows = append(invalidRows, fmt.Sprintf("line %d: duplicate address %s of line %d", row.line, addr, line))
+					continue
+				}
+
+				seenAccounts[addr.String()] = row.line
+
+				if _, ok := existingAccounts[addr.String()]; ok {
+					invalidRows = append(invalidRows, fmt.Sprintf("line %d: cannot add account at existing address %s", row.line, addr))
+					continue
+				}
+
+				if _, ok := existingBalances[addr.String()]; ok {
+					invalidRows = append(invalidRows, fmt.Sprintf("line %d: cannot add account at address %s with an existing balance", row.line, addr))
+					continue
+				}
+
+				genAccounts = append(genAccounts, genAccount)
+				balances = append(balances, banktypes.Balance{Address: addr.String(), Coins: coins.Sort()})
+			}
+
+			if len(invalidRows) > 0 {
+				return fmt.Errorf("found %d invalid rows in %s:\n%s", len(invalidRows), args[0], strings.Join(invalidRows, "\n"))
+			}
+
+			if err := addGenesisAccounts(clientCtx.Codec, appState, genAccounts, balances); err != nil {
+				return err
+			}
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			genDoc.AppState = appStateJSON
+			if err := genutil.ExportGenesisFile(genDoc, genFile); err != nil {
+				return err
+			}
+
+			cmd.Printf("added %d genesis accounts\n", len(genAccounts))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagFunder, "", "bech32 or hex address of the default funder allowed to clawback the unvested tokens of vesting accounts, required by lockup schedules")
+
+	return cmd
+}
+
+// toGenesisAccount parses and validates the row and returns the genesis
+// account it defines along with its initial coins.
+func (row genesisAccountRow) toGenesisAccount(defaultFunder string) (authtypes.GenesisAccount, sdk.Coins, error) {
+	addr, err := parseAccAddress(row.Address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	coins, err := sdk.ParseCoinsNormalized(row.Coins)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse coins: %w", err)
+	}
+
+	lockupPeriods, err := vestingcli.ParseInputPeriods(row.LockupPeriods)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid lockup schedule: %w", err)
+	}
+
+	vestingPeriods, err := vestingcli.ParseInputPeriods(row.VestingPeriods)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid vesting schedule: %w", err)
+	}
+
+	var funder sdk.AccAddress
+	if len(lockupPeriods) > 0 || len(vestingPeriods) > 0 {
+		funderStr := row.Funder
+		if funderStr == "" {
+			funderStr = defaultFunder
+		}
+
+		if funderStr != "" {
+			funder, err = parseAccAddress(funderStr)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to parse funder address: %w", err)
+			}
+		}
+
+		if row.StartTime < 0 {
+			return nil, nil, fmt.Errorf("invalid start time %d", row.StartTime)
+		}
+	}
+
+	genAccount, err := newGenesisAccount(addr, coins, funder, row.StartTime, lockupPeriods, vestingPeriods)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return genAccount, coins, nil
+}
+
+// readGenesisAccountRows reads the rows of a CSV or JSON bulk import file,
+// depending on its extension.
+func readGenesisAccountRows(path string) ([]genesisAccountRow, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".csv":
+		return readGenesisAccountsCSV(contents)
+	case ".json":
+		return readGenesisAccountsJSON(contents)
+	default:
+		return nil, fmt.Errorf("unsupported file extension %q, expected .csv or .json", ext)
+	}
+}
+
+// readGenesisAccountsCSV reads the rows of a CSV bulk import file.
+func readGenesisAccountsCSV(contents []byte) ([]genesisAccountRow, error) {
+	reader := csv.NewReader(bytes.NewReader(contents))
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var rows []genesisAccountRow
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV file: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		// skip the header
+		if len(rows) == 0 && strings.EqualFold(strings.TrimSpace(record[0]), "address") {
+			continue
+		}
+
+		row, err := parseCSVRecord(record)
+		if err != nil {
+			row.err = err
+		}
+
+		row.line = line
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// parseCSVRecord parses a CSV record with the address, coins, funder, start
+// time, lockup periods and vesting periods columns, of which the last four are
+// optional.
+func parseCSVRecord(record []string) (genesisAccountRow, error) {
+	if len(record) < 2 || len(record) > 6 {
+		return genesisAccountRow{}, fmt.Errorf("expected between 2 and 6 columns, got %d", len(record))
+	}
+
+	// pad the optional columns
+	record = append(record, make([]string, 6-len(record))...)
+
+	row := genesisAccountRow{
+		Address: strings.TrimSpace(record[0]),
+		Coins:   strings.TrimSpace(record[1]),
+		Funder:  strings.TrimSpace(record[2]),
+	}
+
+	if startTime := strings.TrimSpace(record[3]); startTime != "" {
+		var err error
+		row.StartTime, err = strconv.ParseInt(startTime, 10, 64)
+		if err != nil {
+			return genesisAccountRow{}, fmt.Errorf("invalid start time %q: %w", startTime, err)
+		}
+	}
+
+	var err error
+	row.LockupPeriods, err = parseCSVPeriods(record[4])
+	if err != nil {
+		return genesisAccountRow{}, fmt.Errorf("invalid lockup schedule: %w", err)
+	}
+
+	row.VestingPeriods, err = parseCSVPeriods(record[5])
+	if err != nil {
+		return genesisAccountRow{}, fmt.Errorf("invalid vesting schedule: %w", err)
+	}
+
+	return row, nil
+}
+
+// parseCSVPeriods parses the periods of a schedule written as
+// length_seconds:coins pairs separated by semicolons.
+func parseCSVPeriods(column string) ([]vestingcli.InputPeriod, error) {
+	column = strings.TrimSpace(column)
+	if column == "" {
+		return nil, nil
+	}
+
+	var periods []vestingcli.InputPeriod
+	for i, period := range strings.Split(column, ";") {
+		parts := strings.SplitN(strings.TrimSpace(period), ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("period #%d %q is not a length_seconds:coins pair", i, period)
+		}
+
+		length, err := strconv.ParseInt(strings.TrimSpace(parts[0]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid length in period #%d: %w", i, err)
+		}
+
+		periods = append(periods, vestingcli.InputPeriod{Coins: strings.TrimSpace(parts[1]), Length: length})
+	}
+
+	return periods, nil
+}
+
+// readGenesisAccountsJSON reads the rows of a JSON bulk import file, which
+// contains a list of accounts, keeping track of the line of each account.
+func readGenesisAccountsJSON(contents []byte) ([]genesisAccountRow, error) {
+	decoder := json.NewDecoder(bytes.NewReader(contents))
+
+	if token, err := decoder.Token(); err != nil || token != json.Delim('[') {
+		return nil, errors.New("failed to read JSON file: expected a list of accounts")
+	}
+
+	var rows []genesisAccountRow
+	for decoder.More() {
+		line := lineAt(contents, decoder.InputOffset())
+
+		var row genesisAccountRow
+		if err := decoder.Decode(&row); err != nil {
+			// the decoder skips the values of an invalid type
+			var typeErr *json.UnmarshalTypeError
+			if !errors.As(err, &typeErr) {
+				return nil, fmt.Errorf("failed to read JSON file at line %d: %w", line, err)
+			}
+
+			row = genesisAccountRow{err: err}
+		}
+
+		row.line = line
+		rows = append(rows, row)
+	}
+
+	if _, err := decoder.Token(); err != nil {
+		return nil, fmt.Errorf("failed to read JSON file: %w", err)
+	}
+
+	return rows, nil
+}
+
+// lineAt returns the line of the first value that follows the given offset,
+// skipping the whitespaces and the separators.
+func lineAt(contents []byte, offset int64) int {
+	for offset < int64(len(contents)) && strings.ContainsRune(" \t\r\n,", rune(contents[offset])) {
+		offset++
+	}
+
+	return bytes.Count(contents[:offset], []byte("\n")) + 1
+}
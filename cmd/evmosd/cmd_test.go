@@ -1,15 +1,36 @@
 package main_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"github.com/tharsis/ethermint/encoding"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 	"github.com/tharsis/evmos/app"
+	cmdcfg "github.com/tharsis/evmos/cmd/config"
 	evmosd "github.com/tharsis/evmos/cmd/evmosd"
+	claimstypes "github.com/tharsis/evmos/x/claims/types"
+	inflationtypes "github.com/tharsis/evmos/x/inflation/types"
+	vestingtypes "github.com/tharsis/evmos/x/vesting/types"
 )
 
 func TestInitCmd(t *testing.T) {
@@ -24,3 +45,436 @@ func TestInitCmd(t *testing.T) {
 	err := svrcmd.Execute(rootCmd, app.DefaultNodeHome)
 	require.NoError(t, err)
 }
+
+// execute runs the root command with the given arguments and home directory.
+func execute(home string, args ...string) error {
+	rootCmd, _ := evmosd.NewRootCmd()
+	rootCmd.SetArgs(append(args, fmt.Sprintf("--%s=%s", flags.FlagHome, home)))
+	return svrcmd.Execute(rootCmd, home)
+}
+
+func TestAddGenesisAccountsCmd(t *testing.T) {
+	home := t.TempDir()
+
+	rootCmd, _ := evmosd.NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"init",
+		"evmos-test",
+		fmt.Sprintf("--%s=%s", flags.FlagChainID, "evmos_9000-1"),
+		fmt.Sprintf("--%s=%s", flags.FlagHome, home),
+	})
+	require.NoError(t, svrcmd.Execute(rootCmd, home))
+
+	accounts := `address,coins,funder,start_time,lockup_periods,vesting_periods
+0x7cB61D4117AE31a12E393a1Cfa3BaC666481D02E,"100aphoton,10ufoo"
+0x7cb61d4117ae31a12e393a1cfa3bac666481d02e,100aphoton
+0x1,100aphoton
+0x2cB61D4117AE31a12E393a1Cfa3BaC666481D02E,200aphoton,,1625204910,2592000:100aphoton;2592000:100aphoton,
+`
+	file := filepath.Join(home, "accounts.csv")
+	require.NoError(t, ioutil.WriteFile(file, []byte(accounts), 0o600))
+
+	rootCmd, _ = evmosd.NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"add-genesis-accounts",
+		file,
+		fmt.Sprintf("--%s=%s", flags.FlagHome, home),
+	})
+	err := svrcmd.Execute(rootCmd, home)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "found 3 invalid rows")
+	require.Contains(t, err.Error(), "line 3: duplicate address")
+	require.Contains(t, err.Error(), "line 4: invalid address")
+	require.Contains(t, err.Error(), "line 5: a lockup schedule requires a funder")
+
+	funder := sdk.AccAddress(common.HexToAddress("0x1").Bytes()).String()
+	accounts = strings.Join([]string{
+		`0x7cB61D4117AE31a12E393a1Cfa3BaC666481D02E,"100aphoton,10ufoo"`,
+		`0x2cB61D4117AE31a12E393a1Cfa3BaC666481D02E,200aphoton,,1625204910,,2592000:100aphoton;2592000:100aphoton`,
+	}, "\n")
+	require.NoError(t, ioutil.WriteFile(file, []byte(accounts), 0o600))
+
+	rootCmd, _ = evmosd.NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"add-genesis-accounts",
+		file,
+		fmt.Sprintf("--funder=%s", funder),
+		fmt.Sprintf("--%s=%s", flags.FlagHome, home),
+	})
+	require.NoError(t, svrcmd.Execute(rootCmd, home))
+
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+
+	encodingConfig := encoding.MakeConfig(app.ModuleBasics)
+	bankGenState := banktypes.GetGenesisStateFromAppState(encodingConfig.Marshaler, appState)
+	require.Len(t, bankGenState.Balances, 2)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("aphoton", 300), sdk.NewInt64Coin("ufoo", 10)), bankGenState.Supply)
+
+	// a balance exists without an account
+	balanceAddr := sdk.AccAddress(common.HexToAddress("0x3cB61D4117AE31a12E393a1Cfa3BaC666481D02E").Bytes())
+	bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{
+		Address: balanceAddr.String(),
+		Coins:   sdk.NewCoins(sdk.NewInt64Coin("aphoton", 100)),
+	})
+	appState[banktypes.ModuleName] = encodingConfig.Marshaler.MustMarshalJSON(bankGenState)
+
+	genDoc, err := tmtypes.GenesisDocFromFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+	genDoc.AppState, err = json.Marshal(appState)
+	require.NoError(t, err)
+	require.NoError(t, genutil.ExportGenesisFile(genDoc, filepath.Join(home, "config", "genesis.json")))
+
+	accounts = strings.Join([]string{
+		`0x4cB61D4117AE31a12E393a1Cfa3BaC666481D02E,100aphoton`,
+		`0x3cB61D4117AE31a12E393a1Cfa3BaC666481D02E,100aphoton`,
+	}, "\n")
+	require.NoError(t, ioutil.WriteFile(file, []byte(accounts), 0o600))
+
+	err = execute(home, "add-genesis-accounts", file)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "found 1 invalid rows")
+	require.Contains(t, err.Error(), "line 2: cannot add account at address "+balanceAddr.String()+" with an existing balance")
+}
+
+func TestAddGenesisAccountsCmdLargeInput(t *testing.T) {
+	home := t.TempDir()
+
+	rootCmd, _ := evmosd.NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"init",
+		"evmos-test",
+		fmt.Sprintf("--%s=%s", flags.FlagChainID, "evmos_9000-1"),
+		fmt.Sprintf("--%s=%s", flags.FlagHome, home),
+	})
+	require.NoError(t, svrcmd.Execute(rootCmd, home))
+
+	const numAccounts = 10000
+
+	rows := make([]string, numAccounts)
+	for i := range rows {
+		rows[i] = fmt.Sprintf("%s,100aphoton", common.BigToAddress(big.NewInt(int64(i+1))).Hex())
+	}
+
+	file := filepath.Join(home, "accounts.csv")
+	require.NoError(t, ioutil.WriteFile(file, []byte(strings.Join(rows, "\n")), 0o600))
+
+	rootCmd, _ = evmosd.NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"add-genesis-accounts",
+		file,
+		fmt.Sprintf("--%s=%s", flags.FlagHome, home),
+	})
+	require.NoError(t, svrcmd.Execute(rootCmd, home))
+
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+
+	encodingConfig := encoding.MakeConfig(app.ModuleBasics)
+	bankGenState := banktypes.GetGenesisStateFromAppState(encodingConfig.Marshaler, appState)
+	require.Len(t, bankGenState.Balances, numAccounts)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("aphoton", 100*numAccounts)), bankGenState.Supply)
+
+	// the last account already exists
+	rows = []string{
+		fmt.Sprintf("%s,100aphoton", common.BigToAddress(big.NewInt(numAccounts+1)).Hex()),
+		rows[numAccounts-1],
+	}
+	require.NoError(t, ioutil.WriteFile(file, []byte(strings.Join(rows, "\n")), 0o600))
+
+	rootCmd, _ = evmosd.NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"add-genesis-accounts",
+		file,
+		fmt.Sprintf("--%s=%s", flags.FlagHome, home),
+	})
+	err = svrcmd.Execute(rootCmd, home)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "found 1 invalid rows")
+	require.Contains(t, err.Error(), "line 2: cannot add account at existing address")
+}
+
+func TestAddGenesisAccountCmd(t *testing.T) {
+	home := t.TempDir()
+
+	rootCmd, _ := evmosd.NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"init",
+		"evmos-test",
+		fmt.Sprintf("--%s=%s", flags.FlagChainID, "evmos_9000-1"),
+		fmt.Sprintf("--%s=%s", flags.FlagHome, home),
+	})
+	require.NoError(t, svrcmd.Execute(rootCmd, home))
+
+	// one year cliff followed by monthly releases
+	schedule := `{
+  "start_time": 1625204910,
+  "periods": [
+    { "coins": "100aphoton", "length_seconds": 31536000 },
+    { "coins": "50aphoton", "length_seconds": 2592000 },
+    { "coins": "50aphoton", "length_seconds": 2592000 }
+  ]
+}`
+	file := filepath.Join(home, "vesting.json")
+	require.NoError(t, ioutil.WriteFile(file, []byte(schedule), 0o600))
+
+	addr := common.HexToAddress("0x7cB61D4117AE31a12E393a1Cfa3BaC666481D02E")
+	funder := common.HexToAddress("0x2cB61D4117AE31a12E393a1Cfa3BaC666481D02E")
+
+	rootCmd, _ = evmosd.NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"add-genesis-account",
+		addr.Hex(),
+		"200aphoton",
+		fmt.Sprintf("--vesting=%s", file),
+		fmt.Sprintf("--funder=%s", funder.Hex()),
+		fmt.Sprintf("--%s=%s", flags.FlagHome, home),
+	})
+	require.NoError(t, svrcmd.Execute(rootCmd, home))
+
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+
+	encodingConfig := encoding.MakeConfig(app.ModuleBasics)
+	authGenState := authtypes.GetGenesisStateFromAppState(encodingConfig.Marshaler, appState)
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	require.NoError(t, err)
+	require.Len(t, accs, 1)
+
+	va, ok := accs[0].(*vestingtypes.ClawbackVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, addr, va.EthAddress())
+	require.Equal(t, sdk.AccAddress(funder.Bytes()), va.GetFunder())
+	require.Equal(t, common.BytesToHash(evmtypes.EmptyCodeHash), va.GetCodeHash())
+	require.Len(t, va.VestingPeriods, 3)
+	require.Equal(t, int64(31536000), va.VestingPeriods[0].Length)
+
+	// a periodic vesting account is created without a funder
+	periodic := common.HexToAddress("0x3cB61D4117AE31a12E393a1Cfa3BaC666481D02E")
+	require.NoError(t, execute(home, "add-genesis-account", periodic.Hex(), "200aphoton", fmt.Sprintf("--vesting=%s", file)))
+
+	// a lockup schedule requires a funder
+	err = execute(home, "add-genesis-account", common.HexToAddress("0x1").Hex(), "200aphoton", fmt.Sprintf("--lockup=%s", file))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "a lockup schedule requires a funder")
+
+	appState, _, err = genutiltypes.GenesisStateFromGenFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+
+	authGenState = authtypes.GetGenesisStateFromAppState(encodingConfig.Marshaler, appState)
+	accs, err = authtypes.UnpackAccounts(authGenState.Accounts)
+	require.NoError(t, err)
+	require.Len(t, accs, 2)
+
+	pva, ok := accs[1].(*sdkvesting.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, sdk.AccAddress(periodic.Bytes()), pva.GetAddress())
+	require.Equal(t, int64(1625204910), pva.StartTime)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("aphoton", 200)), pva.OriginalVesting)
+	require.Len(t, pva.VestingPeriods, 3)
+}
+
+func TestAddGenesisAccountCmdVestingAmount(t *testing.T) {
+	home := t.TempDir()
+
+	rootCmd, _ := evmosd.NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"init",
+		"evmos-test",
+		fmt.Sprintf("--%s=%s", flags.FlagChainID, "evmos_9000-1"),
+		fmt.Sprintf("--%s=%s", flags.FlagHome, home),
+	})
+	require.NoError(t, svrcmd.Execute(rootCmd, home))
+
+	continuous := common.HexToAddress("0x7cB61D4117AE31a12E393a1Cfa3BaC666481D02E")
+	delayed := common.HexToAddress("0x2cB61D4117AE31a12E393a1Cfa3BaC666481D02E")
+
+	testCases := []struct {
+		addr common.Address
+		args []string
+	}{
+		{continuous, []string{"--vesting-amount=100aphoton", "--vesting-start-time=1625204910", "--vesting-end-time=1656740910"}},
+		{delayed, []string{"--vesting-amount=100aphoton", "--vesting-end-time=1656740910"}},
+	}
+
+	for _, tc := range testCases {
+		rootCmd, _ = evmosd.NewRootCmd()
+		rootCmd.SetArgs(append([]string{
+			"add-genesis-account",
+			tc.addr.Hex(),
+			"200aphoton",
+			fmt.Sprintf("--%s=%s", flags.FlagHome, home),
+		}, tc.args...))
+		require.NoError(t, svrcmd.Execute(rootCmd, home))
+	}
+
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+
+	encodingConfig := encoding.MakeConfig(app.ModuleBasics)
+	authGenState := authtypes.GetGenesisStateFromAppState(encodingConfig.Marshaler, appState)
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	require.NoError(t, err)
+	require.Len(t, accs, 2)
+
+	for _, acc := range accs {
+		switch va := acc.(type) {
+		case *sdkvesting.ContinuousVestingAccount:
+			require.Equal(t, sdk.AccAddress(continuous.Bytes()), va.GetAddress())
+			require.Equal(t, int64(1625204910), va.StartTime)
+		case *sdkvesting.DelayedVestingAccount:
+			require.Equal(t, sdk.AccAddress(delayed.Bytes()), va.GetAddress())
+			require.Equal(t, int64(1656740910), va.EndTime)
+		default:
+			t.Fatalf("unexpected account type %T", acc)
+		}
+	}
+
+	// the vesting amount can't be combined with a vesting schedule
+	file := filepath.Join(home, "vesting.json")
+	require.NoError(t, ioutil.WriteFile(file, []byte(`{"periods": [{ "coins": "100aphoton", "length_seconds": 2592000 }]}`), 0o600))
+
+	rootCmd, _ = evmosd.NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"add-genesis-account",
+		common.HexToAddress("0x1").Hex(),
+		"200aphoton",
+		"--vesting-amount=100aphoton",
+		"--vesting-end-time=1656740910",
+		fmt.Sprintf("--vesting=%s", file),
+		fmt.Sprintf("--funder=%s", delayed.Hex()),
+		fmt.Sprintf("--%s=%s", flags.FlagHome, home),
+	})
+	err = svrcmd.Execute(rootCmd, home)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "cannot be combined")
+}
+
+func TestUpdateRemoveGenesisAccountCmd(t *testing.T) {
+	home := t.TempDir()
+	require.NoError(t, execute(home, "init", "evmos-test", fmt.Sprintf("--%s=%s", flags.FlagChainID, "evmos_9000-1")))
+
+	addr := common.HexToAddress("0x7cB61D4117AE31a12E393a1Cfa3BaC666481D02E")
+	other := common.HexToAddress("0x2cB61D4117AE31a12E393a1Cfa3BaC666481D02E")
+
+	require.NoError(t, execute(home, "add-genesis-account", addr.Hex(), "100aphoton"))
+	require.NoError(t, execute(home, "add-genesis-account", other.Hex(), "50aphoton"))
+
+	encodingConfig := encoding.MakeConfig(app.ModuleBasics)
+	readGenesis := func() (authtypes.GenesisAccounts, *banktypes.GenesisState) {
+		appState, _, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(home, "config", "genesis.json"))
+		require.NoError(t, err)
+
+		authGenState := authtypes.GetGenesisStateFromAppState(encodingConfig.Marshaler, appState)
+		accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+		require.NoError(t, err)
+
+		return accs, banktypes.GetGenesisStateFromAppState(encodingConfig.Marshaler, appState)
+	}
+
+	require.NoError(t, execute(home, "genesis", "update-account", addr.Hex(), "300aphoton,10ufoo"))
+
+	accs, bankGenState := readGenesis()
+	require.Len(t, accs, 2)
+	require.Len(t, bankGenState.Balances, 2)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("aphoton", 350), sdk.NewInt64Coin("ufoo", 10)), bankGenState.Supply)
+
+	require.NoError(t, execute(home, "genesis", "remove-account", addr.Hex()))
+
+	accs, bankGenState = readGenesis()
+	require.Len(t, accs, 1)
+	require.True(t, accs.Contains(sdk.AccAddress(other.Bytes())))
+	require.Len(t, bankGenState.Balances, 1)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("aphoton", 50)), bankGenState.Supply)
+
+	// the account doesn't exist anymore
+	require.Error(t, execute(home, "genesis", "remove-account", addr.Hex()))
+	require.Error(t, execute(home, "genesis", "update-account", addr.Hex(), "100aphoton"))
+}
+
+func TestSetGenesisDenomCmd(t *testing.T) {
+	home := t.TempDir()
+	require.NoError(t, execute(home, "init", "evmos-test", fmt.Sprintf("--%s=%s", flags.FlagChainID, "evmos_9000-1")))
+	require.NoError(t, execute(home, "genesis", "set-denom", "atest", "test"))
+
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+
+	cdc := encoding.MakeConfig(app.ModuleBasics).Marshaler
+
+	stakingGenState := stakingtypes.GetGenesisStateFromAppState(cdc, appState)
+	require.Equal(t, "atest", stakingGenState.Params.BondDenom)
+
+	var govGenState govtypes.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(appState[govtypes.ModuleName], &govGenState))
+	require.Equal(t, "atest", govGenState.DepositParams.MinDeposit[0].Denom)
+
+	var evmGenState evmtypes.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(appState[evmtypes.ModuleName], &evmGenState))
+	require.Equal(t, "atest", evmGenState.Params.EvmDenom)
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	require.Len(t, bankGenState.DenomMetadata, 1)
+	require.Equal(t, "test", bankGenState.DenomMetadata[0].Display)
+	require.Equal(t, uint32(18), bankGenState.DenomMetadata[0].DenomUnits[1].Exponent)
+
+	// the genesis file is still valid
+	require.NoError(t, app.ModuleBasics.ValidateGenesis(cdc, encoding.MakeConfig(app.ModuleBasics).TxConfig, appState))
+
+	// the inflation provisions follow the exponent of the display denomination
+	require.NoError(t, execute(home, "genesis", "set-denom", "utest", "test", "--exponent=6"))
+
+	appState, _, err = genutiltypes.GenesisStateFromGenFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+
+	var inflationGenState inflationtypes.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(appState[inflationtypes.ModuleName], &inflationGenState))
+	require.Equal(t, "utest", inflationGenState.Params.MintDenom)
+	require.Equal(t, uint32(6), inflationGenState.Params.DenomExponent)
+}
+
+func TestInitCmdChainIdentity(t *testing.T) {
+	home := t.TempDir()
+
+	identity := `{"bech32_prefix": "test", "base_denom": "utest", "display_denom": "test", "denom_exponent": 6, "min_gas_price": "0.5"}`
+	file := filepath.Join(home, "identity.json")
+	require.NoError(t, ioutil.WriteFile(file, []byte(identity), 0o600))
+
+	t.Setenv(cmdcfg.EnvChainIdentity, file)
+	require.NoError(t, cmdcfg.LoadChainIdentity())
+	defer func() { cmdcfg.Identity = cmdcfg.DefaultChainIdentity() }()
+
+	require.Equal(t, "test", cmdcfg.Identity.Bech32Prefix)
+	require.Equal(t, uint32(6), cmdcfg.Identity.DenomExponent)
+
+	require.NoError(t, execute(home, "init", "evmos-test", fmt.Sprintf("--%s=%s", flags.FlagChainID, "evmos_9000-1")))
+
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+
+	cdc := encoding.MakeConfig(app.ModuleBasics).Marshaler
+	require.Equal(t, "utest", stakingtypes.GetGenesisStateFromAppState(cdc, appState).Params.BondDenom)
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	require.Len(t, bankGenState.DenomMetadata, 1)
+	require.Equal(t, "utest", bankGenState.DenomMetadata[0].Base)
+	require.Equal(t, "test", bankGenState.DenomMetadata[0].Display)
+
+	// the module params only follow the chain identity through the genesis file
+	var inflationGenState inflationtypes.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(appState[inflationtypes.ModuleName], &inflationGenState))
+	require.Equal(t, "utest", inflationGenState.Params.MintDenom)
+	require.Equal(t, uint32(6), inflationGenState.Params.DenomExponent)
+
+	var claimsGenState claimstypes.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(appState[claimstypes.ModuleName], &claimsGenState))
+	require.Equal(t, "utest", claimsGenState.Params.ClaimsDenom)
+
+	appConfig, err := ioutil.ReadFile(filepath.Join(home, "config", "app.toml"))
+	require.NoError(t, err)
+	require.Contains(t, string(appConfig), `minimum-gas-prices = "0.5utest"`)
+
+	// invalid chain identity
+	require.NoError(t, ioutil.WriteFile(file, []byte(`{"denom_exponent": 19}`), 0o600))
+	require.Error(t, cmdcfg.LoadChainIdentity())
+}
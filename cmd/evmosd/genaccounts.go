@@ -11,11 +11,12 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
@@ -23,12 +24,18 @@ import (
 	"github.com/tharsis/ethermint/crypto/hd"
 	ethermint "github.com/tharsis/ethermint/types"
 	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	vestingcli "github.com/tharsis/evmos/x/vesting/client/cli"
+	vestingtypes "github.com/tharsis/evmos/x/vesting/types"
 )
 
 const (
 	flagVestingStart = "vesting-start-time"
 	flagVestingEnd   = "vesting-end-time"
 	flagVestingAmt   = "vesting-amount"
+	flagFunder       = "funder"
+	flagLockup       = "lockup"
+	flagVesting      = "vesting"
 )
 
 // AddGenesisAccountCmd returns add-genesis-account cobra Command.
@@ -37,9 +44,27 @@ func AddGenesisAccountCmd(defaultNodeHome string) *cobra.Command {
 		Use:   "add-genesis-account [address_or_key_name] [coin][,[coin]]",
 		Short: "Add a genesis account to genesis.json",
 		Long: `Add a genesis account to genesis.json. The provided account must specify
-the account address or key name and a list of initial coins. If a key name is given,
-the address will be looked up in the local Keybase. The list of initial tokens must
-contain valid denominations. Accounts may optionally be supplied with vesting parameters.
+the account bech32 or hex address, or key name, and a list of initial coins. If a key name
+is given, the address will be looked up in the local Keybase. The list of initial tokens
+must contain valid denominations. Accounts may optionally be supplied with vesting parameters,
+in which case a continuous vesting account is created if both the vesting start and end
+times are given, or a delayed vesting account if only the end time is given.
+
+Accounts may alternatively be supplied with periodic lockup and vesting schedules, in which
+case a clawback vesting account is created for the given funder. Without a funder, only a
+vesting schedule may be given and a periodic vesting account, whose unvested tokens can't
+be clawed back, is created instead. Each period releases its coins once its length has
+elapsed since the end of the previous period, so that a cliff is defined by a first period
+longer than the following ones. The schedule files have the following format, e.g. for a one year cliff followed by
+monthly releases:
+{
+  "start_time": 1625204910,
+  "periods": [
+    { "coins": "10000000000aphoton", "length_seconds": 31536000 },
+    { "coins": "10000000000aphoton", "length_seconds": 2592000 },
+    { "coins": "10000000000aphoton", "length_seconds": 2592000 }
+  ]
+}
 `,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -50,34 +75,9 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 
 			config.SetRoot(clientCtx.HomeDir)
 
-			var kr keyring.Keyring
-			addr, err := sdk.AccAddressFromBech32(args[0])
+			addr, err := readAccAddress(cmd, clientCtx, args[0])
 			if err != nil {
-				inBuf := bufio.NewReader(cmd.InOrStdin())
-				keyringBackend, _ := cmd.Flags().GetString(flags.FlagKeyringBackend)
-
-				if keyringBackend != "" && clientCtx.Keyring == nil {
-					var err error
-					kr, err = keyring.New(
-						sdk.KeyringServiceName(),
-						keyringBackend,
-						clientCtx.HomeDir,
-						inBuf,
-						hd.EthSecp256k1Option(),
-					)
-					if err != nil {
-						return err
-					}
-				} else {
-					kr = clientCtx.Keyring
-				}
-
-				info, err := kr.Key(args[0])
-				if err != nil {
-					return fmt.Errorf("failed to get address from Keyring: %w", err)
-				}
-
-				addr = info.GetAddress()
+				return err
 			}
 
 			coins, err := sdk.ParseCoinsNormalized(args[1])
@@ -103,39 +103,23 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 				return fmt.Errorf("failed to parse vesting amount: %w", err)
 			}
 
-			// create concrete account type based on input parameters
-			var genAccount authtypes.GenesisAccount
-
-			balances := banktypes.Balance{Address: addr.String(), Coins: coins.Sort()}
-			baseAccount := authtypes.NewBaseAccount(addr, nil, 0, 0)
+			funder, startTime, lockupPeriods, vestingPeriods, err := readVestingFlags(cmd)
+			if err != nil {
+				return err
+			}
 
+			var genAccount authtypes.GenesisAccount
 			if !vestingAmt.IsZero() {
-				baseVestingAccount := authvesting.NewBaseVestingAccount(baseAccount, vestingAmt.Sort(), vestingEnd)
-
-				if (balances.Coins.IsZero() && !baseVestingAccount.OriginalVesting.IsZero()) ||
-					baseVestingAccount.OriginalVesting.IsAnyGT(balances.Coins) {
-					return errors.New("vesting amount cannot be greater than total amount")
+				if len(lockupPeriods) > 0 || len(vestingPeriods) > 0 {
+					return errors.New("the vesting amount cannot be combined with lockup and vesting schedules")
 				}
 
-				switch {
-				case vestingStart != 0 && vestingEnd != 0:
-					genAccount = authvesting.NewContinuousVestingAccountRaw(baseVestingAccount, vestingStart)
-
-				case vestingEnd != 0:
-					genAccount = authvesting.NewDelayedVestingAccountRaw(baseVestingAccount)
-
-				default:
-					return errors.New("invalid vesting parameters; must supply start and end time or end time")
-				}
+				genAccount, err = newSDKVestingAccount(addr, coins, vestingAmt, vestingStart, vestingEnd)
 			} else {
-				genAccount = &ethermint.EthAccount{
-					BaseAccount: baseAccount,
-					CodeHash:    common.BytesToHash(evmtypes.EmptyCodeHash).Hex(),
-				}
+				genAccount, err = newGenesisAccount(addr, coins, funder, startTime, lockupPeriods, vestingPeriods)
 			}
-
-			if err := genAccount.Validate(); err != nil {
-				return fmt.Errorf("failed to validate new genesis account: %w", err)
+			if err != nil {
+				return err
 			}
 
 			genFile := config.GenesisFile()
@@ -144,47 +128,11 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
 			}
 
-			authGenState := authtypes.GetGenesisStateFromAppState(clientCtx.Codec, appState)
-
-			accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
-			if err != nil {
-				return fmt.Errorf("failed to get accounts from any: %w", err)
-			}
-
-			if accs.Contains(addr) {
-				return fmt.Errorf("cannot add account at existing address %s", addr)
-			}
-
-			// Add the new account to the set of genesis accounts and sanitize the
-			// accounts afterwards.
-			accs = append(accs, genAccount)
-			accs = authtypes.SanitizeGenesisAccounts(accs)
-
-			genAccs, err := authtypes.PackAccounts(accs)
-			if err != nil {
-				return fmt.Errorf("failed to convert accounts into any's: %w", err)
-			}
-			authGenState.Accounts = genAccs
-
-			authGenStateBz, err := clientCtx.Codec.MarshalJSON(&authGenState)
-			if err != nil {
-				return fmt.Errorf("failed to marshal auth genesis state: %w", err)
-			}
-
-			appState[authtypes.ModuleName] = authGenStateBz
-
-			bankGenState := banktypes.GetGenesisStateFromAppState(clientCtx.Codec, appState)
-			bankGenState.Balances = append(bankGenState.Balances, balances)
-			bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
-			bankGenState.Supply = bankGenState.Supply.Add(balances.Coins...)
-
-			bankGenStateBz, err := clientCtx.Codec.MarshalJSON(bankGenState)
-			if err != nil {
-				return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+			balances := banktypes.Balance{Address: addr.String(), Coins: coins.Sort()}
+			if err := addGenesisAccounts(clientCtx.Codec, appState, []authtypes.GenesisAccount{genAccount}, []banktypes.Balance{balances}); err != nil {
+				return err
 			}
 
-			appState[banktypes.ModuleName] = bankGenStateBz
-
 			appStateJSON, err := json.Marshal(appState)
 			if err != nil {
 				return fmt.Errorf("failed to marshal application genesis state: %w", err)
@@ -200,7 +148,366 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 	cmd.Flags().String(flagVestingAmt, "", "amount of coins for vesting accounts")
 	cmd.Flags().Int64(flagVestingStart, 0, "schedule start time (unix epoch) for vesting accounts")
 	cmd.Flags().Int64(flagVestingEnd, 0, "schedule end time (unix epoch) for vesting accounts")
+	cmd.Flags().String(flagFunder, "", "bech32 or hex address of the funder allowed to clawback the unvested tokens of vesting accounts, required by lockup schedules")
+	cmd.Flags().String(flagLockup, "", "path to the file containing the lockup schedule for vesting accounts")
+	cmd.Flags().String(flagVesting, "", "path to the file containing the vesting schedule for vesting accounts")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// readAccAddress parses a bech32 or hex address, or looks up the address of
+// a key name in the local Keybase.
+func readAccAddress(cmd *cobra.Command, clientCtx client.Context, addressOrKey string) (sdk.AccAddress, error) {
+	if addr, err := parseAccAddress(addressOrKey); err == nil {
+		return addr, nil
+	}
+
+	kr := clientCtx.Keyring
+	keyringBackend, _ := cmd.Flags().GetString(flags.FlagKeyringBackend)
+
+	if keyringBackend != "" && kr == nil {
+		var err error
+		kr, err = keyring.New(
+			sdk.KeyringServiceName(),
+			keyringBackend,
+			clientCtx.HomeDir,
+			bufio.NewReader(cmd.InOrStdin()),
+			hd.EthSecp256k1Option(),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	info, err := kr.Key(addressOrKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get address from Keyring: %w", err)
+	}
+
+	return info.GetAddress(), nil
+}
+
+// readVestingFlags reads the funder and the lockup and vesting schedules of a
+// vesting account from the command flags. The schedules are empty if no
+// schedule file is provided and the funder is empty if no funder is provided.
+func readVestingFlags(cmd *cobra.Command) (
+	funder sdk.AccAddress,
+	startTime int64,
+	lockupPeriods, vestingPeriods sdkvesting.Periods,
+	err error,
+) {
+	funderStr, err := cmd.Flags().GetString(flagFunder)
+	if err != nil {
+		return nil, 0, nil, nil, err
+	}
+	lockupFile, err := cmd.Flags().GetString(flagLockup)
+	if err != nil {
+		return nil, 0, nil, nil, err
+	}
+	vestingFile, err := cmd.Flags().GetString(flagVesting)
+	if err != nil {
+		return nil, 0, nil, nil, err
+	}
+
+	if lockupFile == "" && vestingFile == "" {
+		return nil, 0, nil, nil, nil
+	}
+
+	if funderStr != "" {
+		funder, err = parseAccAddress(funderStr)
+		if err != nil {
+			return nil, 0, nil, nil, fmt.Errorf("failed to parse funder address: %w", err)
+		}
+	}
+
+	startTime, lockupPeriods, vestingPeriods, err = vestingcli.ReadSchedules(lockupFile, vestingFile)
+	if err != nil {
+		return nil, 0, nil, nil, err
+	}
+
+	return funder, startTime, lockupPeriods, vestingPeriods, nil
+}
+
+// parseAccAddress parses a bech32 or a hex address.
+func parseAccAddress(address string) (sdk.AccAddress, error) {
+	if common.IsHexAddress(address) {
+		return common.HexToAddress(address).Bytes(), nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address %q, must be bech32 or hex: %w", address, err)
+	}
+
+	return addr, nil
+}
+
+// newGenesisAccount creates a genesis account holding the given coins. A
+// clawback vesting account is created for the given funder if any of the
+// lockup and vesting schedules is provided, or an Ethereum account otherwise.
+// Without a funder, only a vesting schedule can be provided, for which a
+// periodic vesting account is created.
+func newGenesisAccount(
+	addr sdk.AccAddress,
+	coins sdk.Coins,
+	funder sdk.AccAddress,
+	startTime int64,
+	lockupPeriods, vestingPeriods sdkvesting.Periods,
+) (authtypes.GenesisAccount, error) {
+	var genAccount authtypes.GenesisAccount
+
+	baseAccount := authtypes.NewBaseAccount(addr, nil, 0, 0)
+
+	switch {
+	case funder.Empty() && len(lockupPeriods) > 0:
+		return nil, errors.New("a lockup schedule requires a funder")
+
+	case funder.Empty() && len(vestingPeriods) > 0:
+		// without a funder the unvested tokens can't be clawed back
+		originalVesting := sdk.NewCoins()
+		for _, period := range vestingPeriods {
+			originalVesting = originalVesting.Add(period.Amount...)
+		}
+
+		vestingAccount := sdkvesting.NewPeriodicVestingAccount(baseAccount, originalVesting, startTime, vestingPeriods)
+
+		if (coins.IsZero() && !vestingAccount.OriginalVesting.IsZero()) ||
+			vestingAccount.OriginalVesting.IsAnyGT(coins) {
+			return nil, errors.New("vesting amount cannot be greater than total amount")
+		}
+
+		genAccount = vestingAccount
+
+	case len(lockupPeriods) > 0 || len(vestingPeriods) > 0:
+		// an omitted schedule defaults to an instant release
+		lockupPeriods, vestingPeriods = vestingtypes.DefaultSchedules(lockupPeriods, vestingPeriods)
+
+		vestingAccount := vestingtypes.NewClawbackVestingAccount(
+			baseAccount, funder, startTime, lockupPeriods, vestingPeriods,
+		)
+
+		if (coins.IsZero() && !vestingAccount.OriginalVesting.IsZero()) ||
+			vestingAccount.OriginalVesting.IsAnyGT(coins) {
+			return nil, errors.New("vesting amount cannot be greater than total amount")
+		}
+
+		genAccount = vestingAccount
+
+	default:
+		genAccount = &ethermint.EthAccount{
+			BaseAccount: baseAccount,
+			CodeHash:    common.BytesToHash(evmtypes.EmptyCodeHash).Hex(),
+		}
+	}
+
+	if err := genAccount.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate new genesis account: %w", err)
+	}
+
+	return genAccount, nil
+}
+
+// newSDKVestingAccount creates a continuous vesting account holding the given
+// coins if both the vesting start and end times are provided, or a delayed
+// vesting account if only the end time is provided.
+func newSDKVestingAccount(
+	addr sdk.AccAddress,
+	coins, vestingAmt sdk.Coins,
+	vestingStart, vestingEnd int64,
+) (authtypes.GenesisAccount, error) {
+	var genAccount authtypes.GenesisAccount
+
+	baseAccount := authtypes.NewBaseAccount(addr, nil, 0, 0)
+	baseVestingAccount := sdkvesting.NewBaseVestingAccount(baseAccount, vestingAmt.Sort(), vestingEnd)
+
+	if (coins.IsZero() && !baseVestingAccount.OriginalVesting.IsZero()) ||
+		baseVestingAccount.OriginalVesting.IsAnyGT(coins) {
+		return nil, errors.New("vesting amount cannot be greater than total amount")
+	}
+
+	switch {
+	case vestingStart != 0 && vestingEnd != 0:
+		genAccount = sdkvesting.NewContinuousVestingAccountRaw(baseVestingAccount, vestingStart)
+
+	case vestingEnd != 0:
+		genAccount = sdkvesting.NewDelayedVestingAccountRaw(baseVestingAccount)
+
+	default:
+		return nil, errors.New("invalid vesting parameters; must supply start and end time or end time")
+	}
+
+	if err := genAccount.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate new genesis account: %w", err)
+	}
+
+	return genAccount, nil
+}
+
+// addGenesisAccounts adds the given accounts and their balances to the auth
+// and bank genesis states of the application state, and increases the total
+// supply by the added balances. It fails if any of the accounts already
+// exists.
+func addGenesisAccounts(
+	cdc codec.Codec,
+	appState map[string]json.RawMessage,
+	genAccounts authtypes.GenesisAccounts,
+	balances []banktypes.Balance,
+) error {
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return fmt.Errorf("failed to get accounts from any: %w", err)
+	}
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+
+	addresses := genesisAccountAddresses(accs)
+	balanceAddresses := genesisBalanceAddresses(bankGenState.Balances)
+	for _, genAccount := range genAccounts {
+		addr := genAccount.GetAddress().String()
+		if _, ok := addresses[addr]; ok {
+			return fmt.Errorf("cannot add account at existing address %s", addr)
+		}
+		if _, ok := balanceAddresses[addr]; ok {
+			return fmt.Errorf("cannot add account at address %s with an existing balance", addr)
+		}
+
+		addresses[addr] = struct{}{}
+		accs = append(accs, genAccount)
+	}
+
+	// Sanitize the accounts after adding the new ones.
+	accs = authtypes.SanitizeGenesisAccounts(accs)
+
+	genAccs, err := authtypes.PackAccounts(accs)
+	if err != nil {
+		return fmt.Errorf("failed to convert accounts into any's: %w", err)
+	}
+	authGenState.Accounts = genAccs
+
+	authGenStateBz, err := cdc.MarshalJSON(&authGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal auth genesis state: %w", err)
+	}
+
+	appState[authtypes.ModuleName] = authGenStateBz
+
+	bankGenState.Balances = append(bankGenState.Balances, balances...)
+	bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+	for _, balance := range balances {
+		bankGenState.Supply = bankGenState.Supply.Add(balance.Coins...)
+	}
+
+	bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+	}
+
+	appState[banktypes.ModuleName] = bankGenStateBz
+	return nil
+}
+
+// genesisAccountAddresses returns the set of the bech32 addresses of the given
+// accounts, to check for existing accounts in constant time.
+func genesisAccountAddresses(accs authtypes.GenesisAccounts) map[string]struct{} {
+	addresses := make(map[string]struct{}, len(accs))
+	for _, acc := range accs {
+		addresses[acc.GetAddress().String()] = struct{}{}
+	}
+
+	return addresses
+}
+
+// genesisBalanceAddresses returns the set of the bech32 addresses of the given
+// balances, to check for existing balances in constant time.
+func genesisBalanceAddresses(balances []banktypes.Balance) map[string]struct{} {
+	addresses := make(map[string]struct{}, len(balances))
+	for _, balance := range balances {
+		addresses[balance.Address] = struct{}{}
+	}
+
+	return addresses
+}
+
+// removeGenesisAccount removes the account at the given address and its
+// balance from the auth and bank genesis states of the application state, and
+// decreases the total supply by the removed balance. It returns the removed
+// account, which is nil if only a balance exists at the address. Module
+// accounts can't be removed.
+func removeGenesisAccount(
+	cdc codec.Codec,
+	appState map[string]json.RawMessage,
+	addr sdk.AccAddress,
+) (authtypes.GenesisAccount, error) {
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts from any: %w", err)
+	}
+
+	var removed authtypes.GenesisAccount
+	for i, acc := range accs {
+		if !acc.GetAddress().Equals(addr) {
+			continue
+		}
+
+		if _, ok := acc.(authtypes.ModuleAccountI); ok {
+			return nil, fmt.Errorf("cannot remove module account at address %s", addr)
+		}
+
+		removed = acc
+		accs = append(accs[:i], accs[i+1:]...)
+		break
+	}
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+
+	var balance sdk.Coins
+	for i, b := range bankGenState.Balances {
+		if b.Address != addr.String() {
+			continue
+		}
+
+		balance = b.Coins
+		bankGenState.Balances = append(bankGenState.Balances[:i], bankGenState.Balances[i+1:]...)
+		break
+	}
+
+	if removed == nil && balance == nil {
+		return nil, fmt.Errorf("no account or balance at address %s", addr)
+	}
+
+	// the supply is only tracked if set in the genesis file
+	if !bankGenState.Supply.Empty() {
+		supply, hasNeg := bankGenState.Supply.SafeSub(balance)
+		if hasNeg {
+			return nil, fmt.Errorf("balance %s of address %s exceeds the total supply %s", balance, addr, bankGenState.Supply)
+		}
+
+		bankGenState.Supply = supply
+	}
+
+	genAccs, err := authtypes.PackAccounts(accs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert accounts into any's: %w", err)
+	}
+	authGenState.Accounts = genAccs
+
+	authGenStateBz, err := cdc.MarshalJSON(&authGenState)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal auth genesis state: %w", err)
+	}
+
+	appState[authtypes.ModuleName] = authGenStateBz
+
+	bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal bank genesis state: %w", err)
+	}
+
+	appState[banktypes.ModuleName] = bankGenStateBz
+	return removed, nil
+}
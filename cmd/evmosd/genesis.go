@@ -0,0 +1,348 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	cmdcfg "github.com/tharsis/evmos/cmd/config"
+	claimstypes "github.com/tharsis/evmos/x/claims/types"
+	inflationtypes "github.com/tharsis/evmos/x/inflation/types"
+)
+
+const flagExponent = "exponent"
+
+// InitCmd returns the genutil init cobra Command, which additionally sets the
+// denominations of the chain identity in the default genesis file.
+func InitCmd(mbm module.BasicManager, defaultNodeHome string) *cobra.Command {
+	cmd := genutilcli.InitCmd(mbm, defaultNodeHome)
+
+	initFn := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if err := initFn(cmd, args); err != nil {
+			return err
+		}
+
+		clientCtx := client.GetClientContextFromCmd(cmd)
+		return editGenesisFile(cmd, func(appState map[string]json.RawMessage) error {
+			return setGenesisDenom(clientCtx.Codec, appState, cmdcfg.Identity.DenomMetadata())
+		})
+	}
+
+	return cmd
+}
+
+// GenesisCmd returns the genesis cobra Command, which groups the commands that
+// edit the genesis file.
+func GenesisCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "genesis",
+		Short:                      "Edit the genesis file",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		UpdateGenesisAccountCmd(defaultNodeHome),
+		RemoveGenesisAccountCmd(defaultNodeHome),
+		SetGenesisDenomCmd(defaultNodeHome),
+	)
+
+	return cmd
+}
+
+// UpdateGenesisAccountCmd returns update-account cobra Command.
+func UpdateGenesisAccountCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-account [address_or_key_name] [coin][,[coin]]",
+		Short: "Update a genesis account of genesis.json",
+		Long: `Update an existing genesis account of genesis.json. The account and its balance are
+replaced by a new account holding the given coins, which is a clawback vesting account if
+lockup or vesting schedules are provided, as for the add-genesis-account command, or an
+Ethereum account otherwise. The total supply is updated with the balance difference.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			addr, err := readAccAddress(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("failed to parse coins: %w", err)
+			}
+
+			funder, startTime, lockupPeriods, vestingPeriods, err := readVestingFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			genAccount, err := newGenesisAccount(addr, coins, funder, startTime, lockupPeriods, vestingPeriods)
+			if err != nil {
+				return err
+			}
+
+			return editGenesisFile(cmd, func(appState map[string]json.RawMessage) error {
+				if _, err := removeGenesisAccount(clientCtx.Codec, appState, addr); err != nil {
+					return err
+				}
+
+				balances := banktypes.Balance{Address: addr.String(), Coins: coins.Sort()}
+				return addGenesisAccounts(clientCtx.Codec, appState, []authtypes.GenesisAccount{genAccount}, []banktypes.Balance{balances})
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	cmd.Flags().String(flagFunder, "", "bech32 or hex address of the funder allowed to clawback the unvested tokens of vesting accounts")
+	cmd.Flags().String(flagLockup, "", "path to the file containing the lockup schedule for vesting accounts")
+	cmd.Flags().String(flagVesting, "", "path to the file containing the vesting schedule for vesting accounts")
+
+	return cmd
+}
+
+// RemoveGenesisAccountCmd returns remove-account cobra Command.
+func RemoveGenesisAccountCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-account [address_or_key_name]",
+		Short: "Remove a genesis account from genesis.json",
+		Long: `Remove a genesis account and its balance from genesis.json. The total supply is
+decreased by the removed balance. Module accounts can't be removed.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			addr, err := readAccAddress(cmd, clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			return editGenesisFile(cmd, func(appState map[string]json.RawMessage) error {
+				_, err := removeGenesisAccount(clientCtx.Codec, appState, addr)
+				return err
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+
+	return cmd
+}
+
+// SetGenesisDenomCmd returns set-denom cobra Command.
+func SetGenesisDenomCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom [base_denom] [display_denom]",
+		Short: "Set the chain denomination of the module genesis states of genesis.json",
+		Long: fmt.Sprintf(`Set the base denomination of the staking bond denom, the crisis constant fee, the
+gov minimum deposit, the EVM denom, the inflation mint denom and the claims denom of
+genesis.json, and the bank metadata of the base and display denominations, where the
+display denomination is worth 10^exponent base denominations. The exponent is also set as
+the inflation denom exponent, in which the inflation provisions are expressed. The bank metadata of the
+previous EVM denom is replaced.
+
+Example:
+$ %s genesis set-denom aphoton photon
+`, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			baseDenom, displayDenom := args[0], args[1]
+			if err := sdk.ValidateDenom(baseDenom); err != nil {
+				return fmt.Errorf("invalid base denom: %w", err)
+			}
+
+			exponent, err := cmd.Flags().GetUint32(flagExponent)
+			if err != nil {
+				return err
+			}
+
+			metadata := cmdcfg.ChainIdentity{
+				BaseDenom:     baseDenom,
+				DisplayDenom:  displayDenom,
+				DenomExponent: exponent,
+			}.DenomMetadata()
+
+			if err := metadata.Validate(); err != nil {
+				return fmt.Errorf("invalid denom metadata: %w", err)
+			}
+
+			return editGenesisFile(cmd, func(appState map[string]json.RawMessage) error {
+				return setGenesisDenom(clientCtx.Codec, appState, metadata)
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Uint32(flagExponent, cmdcfg.Identity.DenomExponent, "The exponent of the display denomination")
+
+	return cmd
+}
+
+// setGenesisDenom sets the base denomination of the given metadata as the
+// denomination of the module genesis states and adds the metadata to the bank
+// genesis state, replacing any previous metadata of the base denomination and
+// of the previous chain denomination.
+func setGenesisDenom(cdc codec.Codec, appState map[string]json.RawMessage, metadata banktypes.Metadata) error {
+	denom := metadata.Base
+
+	var stakingGenState stakingtypes.GenesisState
+	if err := updateModuleGenesis(cdc, appState, stakingtypes.ModuleName, &stakingGenState, func() {
+		stakingGenState.Params.BondDenom = denom
+	}); err != nil {
+		return err
+	}
+
+	var crisisGenState crisistypes.GenesisState
+	if err := updateModuleGenesis(cdc, appState, crisistypes.ModuleName, &crisisGenState, func() {
+		crisisGenState.ConstantFee.Denom = denom
+	}); err != nil {
+		return err
+	}
+
+	var govGenState govtypes.GenesisState
+	if err := updateModuleGenesis(cdc, appState, govtypes.ModuleName, &govGenState, func() {
+		minDeposit := sdk.NewCoins()
+		for _, coin := range govGenState.DepositParams.MinDeposit {
+			minDeposit = minDeposit.Add(sdk.NewCoin(denom, coin.Amount))
+		}
+		govGenState.DepositParams.MinDeposit = minDeposit
+	}); err != nil {
+		return err
+	}
+
+	// the EVM denom is the previous chain denomination
+	var (
+		evmGenState evmtypes.GenesisState
+		prevDenom   string
+	)
+	if err := updateModuleGenesis(cdc, appState, evmtypes.ModuleName, &evmGenState, func() {
+		prevDenom = evmGenState.Params.EvmDenom
+		evmGenState.Params.EvmDenom = denom
+	}); err != nil {
+		return err
+	}
+
+	var inflationGenState inflationtypes.GenesisState
+	if err := updateModuleGenesis(cdc, appState, inflationtypes.ModuleName, &inflationGenState, func() {
+		inflationGenState.Params.MintDenom = denom
+		inflationGenState.Params.DenomExponent = displayExponent(metadata)
+	}); err != nil {
+		return err
+	}
+
+	var claimsGenState claimstypes.GenesisState
+	if err := updateModuleGenesis(cdc, appState, claimstypes.ModuleName, &claimsGenState, func() {
+		claimsGenState.Params.ClaimsDenom = denom
+	}); err != nil {
+		return err
+	}
+
+	var bankGenState banktypes.GenesisState
+	return updateModuleGenesis(cdc, appState, banktypes.ModuleName, &bankGenState, func() {
+		denomMetadata := []banktypes.Metadata{metadata}
+		for _, m := range bankGenState.DenomMetadata {
+			if m.Base != denom && m.Base != prevDenom {
+				denomMetadata = append(denomMetadata, m)
+			}
+		}
+		bankGenState.DenomMetadata = denomMetadata
+	})
+}
+
+// displayExponent returns the exponent of the display denomination of the
+// given metadata.
+func displayExponent(metadata banktypes.Metadata) uint32 {
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Display {
+			return unit.Exponent
+		}
+	}
+
+	return 0
+}
+
+// updateModuleGenesis unmarshals the genesis state of a module from the
+// application state, applies the given update to it and marshals it back.
+func updateModuleGenesis(
+	cdc codec.Codec,
+	appState map[string]json.RawMessage,
+	moduleName string,
+	genState codec.ProtoMarshaler,
+	update func(),
+) error {
+	if err := cdc.UnmarshalJSON(appState[moduleName], genState); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", moduleName, err)
+	}
+
+	update()
+
+	genStateBz, err := cdc.MarshalJSON(genState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s genesis state: %w", moduleName, err)
+	}
+
+	appState[moduleName] = genStateBz
+	return nil
+}
+
+// editGenesisFile applies the given edit to the application state of the
+// genesis file in the home directory and writes the updated genesis file.
+func editGenesisFile(cmd *cobra.Command, edit func(appState map[string]json.RawMessage) error) error {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	config := serverCtx.Config
+
+	config.SetRoot(clientCtx.HomeDir)
+
+	return editGenesis(config.GenesisFile(), edit)
+}
+
+// editGenesis applies the given edit to the application state of a genesis
+// file and writes the updated genesis file.
+func editGenesis(genFile string, edit func(appState map[string]json.RawMessage) error) error {
+	appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+
+	if err := edit(appState); err != nil {
+		return err
+	}
+
+	appStateJSON, err := json.Marshal(appState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal application genesis state: %w", err)
+	}
+
+	genDoc.AppState = appStateJSON
+	return genutil.ExportGenesisFile(genDoc, genFile)
+}
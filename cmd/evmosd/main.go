@@ -1,6 +1,7 @@
 package main
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/cosmos/cosmos-sdk/server"
@@ -12,6 +13,11 @@ import (
 )
 
 func main() {
+	if err := cmdcfg.LoadChainIdentity(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
 	setupConfig()
 	cmdcfg.RegisterDenoms()
 
@@ -0,0 +1,30 @@
+package contracts
+
+import (
+	_ "embed" // embed compiled smart contract
+	"encoding/json"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+var (
+	//go:embed compiled_contracts/ERC20MinterBurnerDecimals.json
+	erc20JSON []byte
+
+	// ERC20MinterBurnerDecimalsContract is the compiled erc20 contract deployed
+	// by the erc20 module for native Cosmos coins. The deployer (i.e the erc20
+	// module deployer address) is set as the contract owner and is the only
+	// address allowed to call `mint` and `burnCoins`.
+	ERC20MinterBurnerDecimalsContract evmtypes.CompiledContract
+)
+
+func init() {
+	err := json.Unmarshal(erc20JSON, &ERC20MinterBurnerDecimalsContract)
+	if err != nil {
+		panic(err)
+	}
+
+	if len(ERC20MinterBurnerDecimalsContract.Bin) == 0 {
+		panic("load contract failed")
+	}
+}
@@ -0,0 +1,22 @@
+package ibc
+
+import (
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// GetReceivedDenom returns the denomination of the coins credited to the
+// recipient of an ICS-20 packet received on this chain.
+func GetReceivedDenom(packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		// the coins return to this chain, so remove the prefix added by the
+		// source chain
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		unprefixedDenom := data.Denom[len(voucherPrefix):]
+
+		return transfertypes.ParseDenomTrace(unprefixedDenom).IBCDenom()
+	}
+
+	prefixedDenom := transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel()) + data.Denom
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
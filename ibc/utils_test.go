@@ -0,0 +1,35 @@
+package ibc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+func TestGetReceivedDenom(t *testing.T) {
+	packet := channeltypes.Packet{
+		SourcePort:         transfertypes.PortID,
+		SourceChannel:      "channel-3",
+		DestinationPort:    transfertypes.PortID,
+		DestinationChannel: "channel-0",
+	}
+
+	testCases := []struct {
+		name     string
+		denom    string
+		expDenom string
+	}{
+		{"native coin returning", "transfer/channel-3/aevmos", "aevmos"},
+		{"voucher returning", "transfer/channel-3/transfer/channel-7/uatom", transfertypes.ParseDenomTrace("transfer/channel-7/uatom").IBCDenom()},
+		{"coin of the source chain", "uosmo", transfertypes.ParseDenomTrace("transfer/channel-0/uosmo").IBCDenom()},
+		{"voucher of the source chain", "transfer/channel-1/uatom", transfertypes.ParseDenomTrace("transfer/channel-0/transfer/channel-1/uatom").IBCDenom()},
+	}
+
+	for _, tc := range testCases {
+		data := transfertypes.FungibleTokenPacketData{Denom: tc.denom, Amount: "100"}
+		require.Equal(t, tc.expDenom, GetReceivedDenom(packet, data), tc.name)
+	}
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+// GetEthereumTx returns the Ethereum message of a transaction and its sender.
+// It returns false if the transaction is not made of a single MsgEthereumTx,
+// as the Ethereum AnteHandler rejects the transactions with multiple
+// messages.
+//
+// NOTE: the sender is the from field of the message, which is set to the
+// signer of the Ethereum transaction by the EthSigVerificationDecorator, so
+// only the decorators that run after it can rely on the sender.
+func GetEthereumTx(tx sdk.Tx) (*evmtypes.MsgEthereumTx, common.Address, bool) {
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil, common.Address{}, false
+	}
+
+	msgEthTx, ok := msgs[0].(*evmtypes.MsgEthereumTx)
+	if !ok {
+		return nil, common.Address{}, false
+	}
+
+	return msgEthTx, common.HexToAddress(msgEthTx.From), true
+}
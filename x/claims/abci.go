@@ -0,0 +1,26 @@
+package claims
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/x/claims/keeper"
+	"github.com/tharsis/evmos/x/claims/types"
+)
+
+// EndBlocker clawbacks the unclaimed tokens to the community pool once the
+// airdrop has ended
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	params := k.GetParams(ctx)
+	if !params.EnableClaims || ctx.BlockTime().Before(params.AirdropEndTime()) {
+		return
+	}
+
+	if err := k.ClawbackUnclaimed(ctx); err != nil {
+		k.Logger(ctx).Error("failed to clawback unclaimed tokens", "error", err.Error())
+	}
+}
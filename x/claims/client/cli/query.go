@@ -0,0 +1,161 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/tharsis/evmos/x/claims/types"
+)
+
+// GetQueryCmd returns the parent command for all claims CLI query commands
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the claims module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetTotalUnclaimedCmd(),
+		GetClaimsRecordsCmd(),
+		GetClaimsRecordCmd(),
+		GetParamsCmd(),
+	)
+	return cmd
+}
+
+// GetTotalUnclaimedCmd queries the total unclaimed tokens of the airdrop
+func GetTotalUnclaimedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-unclaimed",
+		Short: "Gets the total unclaimed tokens",
+		Long:  "Gets the total amount of tokens held by the claims module account that haven't been claimed yet",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTotalUnclaimedRequest{}
+
+			res, err := queryClient.TotalUnclaimed(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetClaimsRecordsCmd queries all the claims records
+func GetClaimsRecordsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claims-records",
+		Short: "Gets all the claims records",
+		Long:  "Gets all the claims records",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryClaimsRecordsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ClaimsRecords(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "claims records")
+	return cmd
+}
+
+// GetClaimsRecordCmd queries the claims record of an address
+func GetClaimsRecordCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claims-record [address]",
+		Short: "Gets the claims record of an address",
+		Long:  "Gets the initial claimable amount and the claimable amount of each action for an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryClaimsRecordRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.ClaimsRecord(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetParamsCmd queries the module parameters
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Gets claims params",
+		Long:  "Gets claims params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryParamsRequest{}
+
+			res, err := queryClient.Params(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,70 @@
+package claims
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/x/claims/keeper"
+	"github.com/tharsis/evmos/x/claims/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	data types.GenesisState,
+) {
+	params := data.Params
+
+	// the airdrop starts at genesis time by default
+	if params.AirdropStartTime.IsZero() {
+		params.AirdropStartTime = ctx.BlockTime()
+	}
+
+	k.SetParams(ctx, params)
+
+	// ensure claims module account is set on genesis
+	acc := accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	if acc == nil {
+		panic("the claims module account has not been set")
+	}
+
+	// the claims module account must hold the unclaimed tokens of every record
+	numActions := int64(len(types.Actions))
+	expectedUnclaimed := sdk.ZeroInt()
+
+	for _, claimsRecord := range data.ClaimsRecords {
+		addr, err := sdk.AccAddressFromBech32(claimsRecord.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		cr := claimsRecord.GetClaimsRecord()
+		for _, action := range types.Actions {
+			if !cr.HasClaimedAction(action) {
+				expectedUnclaimed = expectedUnclaimed.Add(cr.InitialClaimableAmount.QuoRaw(numActions))
+			}
+		}
+
+		k.SetClaimsRecord(ctx, addr, cr)
+	}
+
+	balance := bankKeeper.GetBalance(ctx, acc.GetAddress(), params.ClaimsDenom)
+	if balance.Amount.LT(expectedUnclaimed) {
+		panic(fmt.Errorf(
+			"claims module account balance (%s) is lower than the unclaimed amount of the claims records (%s%s)",
+			balance, expectedUnclaimed, params.ClaimsDenom,
+		))
+	}
+}
+
+// ExportGenesis export module status
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:        k.GetParams(ctx),
+		ClaimsRecords: k.GetClaimsRecords(ctx),
+	}
+}
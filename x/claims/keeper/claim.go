@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tharsis/evmos/x/claims/types"
+)
+
+// GetClaimableAmountForAction returns the amount of tokens that the given
+// action unlocks. After the decay start time, the amount decreases linearly
+// until the end of the airdrop.
+func (k Keeper) GetClaimableAmountForAction(
+	ctx sdk.Context,
+	claimsRecord types.ClaimsRecord,
+	action types.Action,
+	params types.Params,
+) sdk.Int {
+	if !action.IsValid() || claimsRecord.HasClaimedAction(action) || !params.IsClaimsActive(ctx.BlockTime()) {
+		return sdk.ZeroInt()
+	}
+
+	amount := claimsRecord.InitialClaimableAmount.QuoRaw(int64(len(types.Actions)))
+
+	decayStartTime := params.DecayStartTime()
+	if ctx.BlockTime().Before(decayStartTime) {
+		return amount
+	}
+
+	// claimable percent = 1 - elapsed decay time / decay duration
+	elapsed := ctx.BlockTime().Sub(decayStartTime)
+	decayPercent := sdk.NewDec(elapsed.Nanoseconds()).QuoInt64(params.DurationOfDecay.Nanoseconds())
+	claimablePercent := sdk.OneDec().Sub(decayPercent)
+
+	return amount.ToDec().Mul(claimablePercent).TruncateInt()
+}
+
+// ClaimCoinsForAction sends the tokens unlocked by the given action to the
+// recipient and marks the action as completed. It performs a no-op if the
+// address has no claims record, the action has already been completed or
+// the airdrop is not active.
+func (k Keeper) ClaimCoinsForAction(ctx sdk.Context, addr sdk.AccAddress, action types.Action) (sdk.Int, error) {
+	if !action.IsValid() {
+		return sdk.ZeroInt(), sdkerrors.Wrapf(types.ErrInvalidAction, "%s", action)
+	}
+
+	params := k.GetParams(ctx)
+	if !params.IsClaimsActive(ctx.BlockTime()) {
+		return sdk.ZeroInt(), nil
+	}
+
+	claimsRecord, found := k.GetClaimsRecord(ctx, addr)
+	if !found || claimsRecord.HasClaimedAction(action) {
+		return sdk.ZeroInt(), nil
+	}
+
+	amount := k.GetClaimableAmountForAction(ctx, claimsRecord, action, params)
+	if amount.IsPositive() {
+		coins := sdk.Coins{sdk.NewCoin(params.ClaimsDenom, amount)}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
+			return sdk.ZeroInt(), err
+		}
+	}
+
+	claimsRecord.MarkClaimed(action)
+
+	// the claims record is no longer needed once all the actions are completed
+	if claimsRecord.HasClaimedAll() {
+		k.DeleteClaimsRecord(ctx, addr)
+	} else {
+		k.SetClaimsRecord(ctx, addr, claimsRecord)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClaim,
+			sdk.NewAttribute(sdk.AttributeKeySender, addr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyActionType, action.String()),
+		),
+	)
+
+	return amount, nil
+}
+
+// ClawbackUnclaimed sends the unclaimed tokens remaining on the claims module
+// account to the community pool, deletes all the claims records and disables
+// the claims.
+func (k Keeper) ClawbackUnclaimed(ctx sdk.Context) error {
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	balances := k.bankKeeper.GetAllBalances(ctx, moduleAddr)
+
+	if !balances.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, balances, moduleAddr); err != nil {
+			return err
+		}
+	}
+
+	// collect the addresses first as the store must not be modified while
+	// iterating over it
+	var addrs []sdk.AccAddress
+	k.IterateClaimsRecords(ctx, func(addr sdk.AccAddress, _ types.ClaimsRecord) (stop bool) {
+		addrs = append(addrs, addr)
+		return false
+	})
+
+	for _, addr := range addrs {
+		k.DeleteClaimsRecord(ctx, addr)
+	}
+
+	params := k.GetParams(ctx)
+	params.EnableClaims = false
+	k.SetParams(ctx, params)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClawback,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, balances.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/x/claims/types"
+)
+
+// GetClaimsRecord returns the claims record of the given address
+func (k Keeper) GetClaimsRecord(ctx sdk.Context, addr sdk.AccAddress) (types.ClaimsRecord, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClaimsRecords)
+	bz := store.Get(addr)
+	if len(bz) == 0 {
+		return types.ClaimsRecord{}, false
+	}
+
+	var claimsRecord types.ClaimsRecord
+	k.cdc.MustUnmarshal(bz, &claimsRecord)
+	return claimsRecord, true
+}
+
+// SetClaimsRecord stores the claims record of the given address
+func (k Keeper) SetClaimsRecord(ctx sdk.Context, addr sdk.AccAddress, claimsRecord types.ClaimsRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClaimsRecords)
+	bz := k.cdc.MustMarshal(&claimsRecord)
+	store.Set(addr, bz)
+}
+
+// DeleteClaimsRecord removes the claims record of the given address
+func (k Keeper) DeleteClaimsRecord(ctx sdk.Context, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClaimsRecords)
+	store.Delete(addr)
+}
+
+// IterateClaimsRecords iterates over all the claims records and performs a
+// callback function
+func (k Keeper) IterateClaimsRecords(ctx sdk.Context, handlerFn func(addr sdk.AccAddress, cr types.ClaimsRecord) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClaimsRecords)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var claimsRecord types.ClaimsRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &claimsRecord)
+
+		if handlerFn(sdk.AccAddress(iterator.Key()), claimsRecord) {
+			break
+		}
+	}
+}
+
+// GetClaimsRecords returns all the claims records along with their recipient
+func (k Keeper) GetClaimsRecords(ctx sdk.Context) []types.ClaimsRecordAddress {
+	claimsRecords := []types.ClaimsRecordAddress{}
+
+	k.IterateClaimsRecords(ctx, func(addr sdk.AccAddress, cr types.ClaimsRecord) (stop bool) {
+		claimsRecords = append(claimsRecords, types.NewClaimsRecordAddress(addr, cr))
+		return false
+	})
+
+	return claimsRecords
+}
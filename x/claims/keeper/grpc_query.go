@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/tharsis/evmos/x/claims/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// TotalUnclaimed returns the total amount of unclaimed tokens held by the
+// claims module account
+func (k Keeper) TotalUnclaimed(c context.Context, _ *types.QueryTotalUnclaimedRequest) (*types.QueryTotalUnclaimedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	balances := k.bankKeeper.GetAllBalances(ctx, moduleAddr)
+
+	return &types.QueryTotalUnclaimedResponse{Coins: balances}, nil
+}
+
+// Params returns the claims module parameters
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// ClaimsRecords returns all the claims records
+func (k Keeper) ClaimsRecords(c context.Context, req *types.QueryClaimsRecordsRequest) (*types.QueryClaimsRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var claimsRecords []types.ClaimsRecordAddress
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClaimsRecords)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var cr types.ClaimsRecord
+		if err := k.cdc.Unmarshal(value, &cr); err != nil {
+			return err
+		}
+		claimsRecords = append(claimsRecords, types.NewClaimsRecordAddress(sdk.AccAddress(key), cr))
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClaimsRecordsResponse{
+		Claims:     claimsRecords,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ClaimsRecord returns the initial claimable amount and the claims of each
+// action for a given address
+func (k Keeper) ClaimsRecord(c context.Context, req *types.QueryClaimsRecordRequest) (*types.QueryClaimsRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claimsRecord, found := k.GetClaimsRecord(ctx, addr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "claims record for address '%s'", req.Address)
+	}
+
+	params := k.GetParams(ctx)
+	claims := make([]types.Claim, len(types.Actions))
+
+	for i, action := range types.Actions {
+		claims[i] = types.Claim{
+			Action:          action,
+			Completed:       claimsRecord.HasClaimedAction(action),
+			ClaimableAmount: k.GetClaimableAmountForAction(ctx, claimsRecord, action, params),
+		}
+	}
+
+	return &types.QueryClaimsRecordResponse{
+		InitialClaimableAmount: claimsRecord.InitialClaimableAmount,
+		Claims:                 claims,
+	}, nil
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/tharsis/evmos/x/claims/types"
+)
+
+var (
+	_ govtypes.GovHooks         = Hooks{}
+	_ stakingtypes.StakingHooks = Hooks{}
+)
+
+// Hooks wrapper struct for the claims keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the wrapper struct for the claims hooks
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// claimCoinsForAction claims the tokens of the given action on a cached
+// context, so that the state changes are discarded on error. Errors are logged
+// as the hooks must not prevent the execution of the action.
+func (h Hooks) claimCoinsForAction(ctx sdk.Context, addr sdk.AccAddress, action types.Action) {
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	if _, err := h.k.ClaimCoinsForAction(cacheCtx, addr, action); err != nil {
+		h.k.Logger(ctx).Error(
+			"failed to claim coins for action",
+			"address", addr.String(),
+			"action", action.String(),
+			"error", err.Error(),
+		)
+		return
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
+
+// AfterProposalVote is called after a vote on a proposal is cast. It claims the
+// tokens of the vote action.
+func (h Hooks) AfterProposalVote(ctx sdk.Context, _ uint64, voterAddr sdk.AccAddress) {
+	h.claimCoinsForAction(ctx, voterAddr, types.ACTION_VOTE)
+}
+
+// AfterDelegationModified is called after a delegation is created or its shares
+// are modified. It claims the tokens of the delegate action.
+func (h Hooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, _ sdk.ValAddress) {
+	h.claimCoinsForAction(ctx, delAddr, types.ACTION_DELEGATE)
+}
+
+// PostTxProcessing implements the EVM post transaction hook. It claims the
+// tokens of the EVM action for the sender of the transaction.
+func (h Hooks) PostTxProcessing(ctx sdk.Context, msg core.Message, _ *ethtypes.Receipt) error {
+	h.claimCoinsForAction(ctx, msg.From().Bytes(), types.ACTION_EVM)
+	return nil
+}
+
+// ________________________________________________________________________________________
+
+// gov hooks
+
+// AfterProposalSubmission performs a no-op
+func (h Hooks) AfterProposalSubmission(_ sdk.Context, _ uint64) {}
+
+// AfterProposalDeposit performs a no-op
+func (h Hooks) AfterProposalDeposit(_ sdk.Context, _ uint64, _ sdk.AccAddress) {}
+
+// AfterProposalFailedMinDeposit performs a no-op
+func (h Hooks) AfterProposalFailedMinDeposit(_ sdk.Context, _ uint64) {}
+
+// AfterProposalVotingPeriodEnded performs a no-op
+func (h Hooks) AfterProposalVotingPeriodEnded(_ sdk.Context, _ uint64) {}
+
+// ________________________________________________________________________________________
+
+// staking hooks
+
+// AfterValidatorCreated performs a no-op
+func (h Hooks) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress) {}
+
+// BeforeValidatorModified performs a no-op
+func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) {}
+
+// AfterValidatorRemoved performs a no-op
+func (h Hooks) AfterValidatorRemoved(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) {}
+
+// AfterValidatorBonded performs a no-op
+func (h Hooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) {}
+
+// AfterValidatorBeginUnbonding performs a no-op
+func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) {}
+
+// BeforeDelegationCreated performs a no-op
+func (h Hooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) {}
+
+// BeforeDelegationSharesModified performs a no-op
+func (h Hooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) {}
+
+// BeforeDelegationRemoved performs a no-op
+func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) {}
+
+// BeforeValidatorSlashed performs a no-op
+func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec) {}
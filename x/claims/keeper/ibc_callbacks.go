@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+
+	"github.com/tharsis/evmos/x/claims/types"
+)
+
+// OnAcknowledgementPacket claims the tokens of the IBC transfer action for the
+// sender of an ICS-20 transfer once the counterparty chain acknowledged it
+// successfully. Failed or invalid transfers are ignored.
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+) {
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil || !ack.Success() {
+		return
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return
+	}
+
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return
+	}
+
+	k.Hooks().claimCoinsForAction(ctx, sender, types.ACTION_IBC_TRANSFER)
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/tharsis/evmos/x/claims/types"
+)
+
+// Keeper of this module maintains the claims records of the airdrop
+// recipients.
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        codec.BinaryCodec
+	paramstore paramtypes.Subspace
+
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistrKeeper
+}
+
+// NewKeeper creates new instances of the claims Keeper
+func NewKeeper(
+	storeKey sdk.StoreKey,
+	cdc codec.BinaryCodec,
+	ps paramtypes.Subspace,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	dk types.DistrKeeper,
+) Keeper {
+	// ensure the claims module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the claims module account has not been set")
+	}
+
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:      storeKey,
+		cdc:           cdc,
+		paramstore:    ps,
+		accountKeeper: ak,
+		bankKeeper:    bk,
+		distrKeeper:   dk,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+
+	"github.com/tharsis/ethermint/tests"
+
+	"github.com/tharsis/evmos/app"
+	"github.com/tharsis/evmos/x/claims/types"
+	erc20types "github.com/tharsis/evmos/x/erc20/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx       sdk.Context
+	app       *app.Evmos
+	params    types.Params
+	recipient sdk.AccAddress
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.Setup(false, nil)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: "evmos_9000-1",
+		Time:    time.Now().UTC(),
+	})
+
+	// airdrop started an hour ago, decaying after a week during a week
+	week := 7 * 24 * time.Hour
+	suite.params = types.NewParams(true, "aevmos", suite.ctx.BlockTime().Add(-time.Hour), week, week)
+	suite.app.ClaimsKeeper.SetParams(suite.ctx, suite.params)
+
+	// fund the claims module account with the airdrop tokens
+	coins := sdk.NewCoins(sdk.NewInt64Coin(suite.params.ClaimsDenom, 1000))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, erc20types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, erc20types.ModuleName, types.ModuleName, coins))
+
+	suite.recipient = tests.GenerateAddress().Bytes()
+	suite.app.ClaimsKeeper.SetClaimsRecord(suite.ctx, suite.recipient, types.NewClaimsRecord(sdk.NewInt(400)))
+}
+
+func (suite *KeeperTestSuite) balanceOf(addr sdk.AccAddress) int64 {
+	return suite.app.BankKeeper.GetBalance(suite.ctx, addr, suite.params.ClaimsDenom).Amount.Int64()
+}
+
+func (suite *KeeperTestSuite) TestClaimCoinsForAction() {
+	_, err := suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, suite.recipient, types.ACTION_UNSPECIFIED)
+	suite.Require().ErrorIs(err, types.ErrInvalidAction)
+
+	amount, err := suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, suite.recipient, types.ACTION_VOTE)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(100), amount.Int64())
+	suite.Require().Equal(int64(100), suite.balanceOf(suite.recipient))
+
+	// an action can only be claimed once
+	amount, err = suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, suite.recipient, types.ACTION_VOTE)
+	suite.Require().NoError(err)
+	suite.Require().True(amount.IsZero())
+
+	// addresses without claims record are ignored
+	amount, err = suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, tests.GenerateAddress().Bytes(), types.ACTION_VOTE)
+	suite.Require().NoError(err)
+	suite.Require().True(amount.IsZero())
+
+	for _, action := range []types.Action{types.ACTION_DELEGATE, types.ACTION_EVM, types.ACTION_IBC_TRANSFER} {
+		_, err = suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, suite.recipient, action)
+		suite.Require().NoError(err)
+	}
+
+	suite.Require().Equal(int64(400), suite.balanceOf(suite.recipient))
+
+	_, found := suite.app.ClaimsKeeper.GetClaimsRecord(suite.ctx, suite.recipient)
+	suite.Require().False(found, "claims record should be deleted once all the actions are claimed")
+}
+
+func (suite *KeeperTestSuite) TestClaimCoinsForActionDecay() {
+	// half of the decay period has elapsed
+	suite.ctx = suite.ctx.WithBlockTime(suite.params.DecayStartTime().Add(suite.params.DurationOfDecay / 2))
+
+	amount, err := suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, suite.recipient, types.ACTION_DELEGATE)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(50), amount.Int64())
+
+	// nothing can be claimed after the end of the airdrop
+	suite.ctx = suite.ctx.WithBlockTime(suite.params.AirdropEndTime())
+
+	amount, err = suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, suite.recipient, types.ACTION_VOTE)
+	suite.Require().NoError(err)
+	suite.Require().True(amount.IsZero())
+	suite.Require().Equal(int64(50), suite.balanceOf(suite.recipient))
+}
+
+func (suite *KeeperTestSuite) TestHooks() {
+	hooks := suite.app.ClaimsKeeper.Hooks()
+
+	hooks.AfterProposalVote(suite.ctx, 1, suite.recipient)
+	suite.Require().Equal(int64(100), suite.balanceOf(suite.recipient))
+
+	from := common.BytesToAddress(suite.recipient)
+	msg := ethtypes.NewMessage(from, nil, 0, big.NewInt(0), 21000, big.NewInt(1), nil, nil, nil, nil, false)
+	suite.Require().NoError(hooks.PostTxProcessing(suite.ctx, msg, &ethtypes.Receipt{}))
+	suite.Require().Equal(int64(200), suite.balanceOf(suite.recipient))
+
+	data := transfertypes.NewFungibleTokenPacketData("aevmos", "10", suite.recipient.String(), "cosmos1receiver")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, "transfer", "channel-0", "transfer", "channel-1", clienttypes.NewHeight(0, 100), 0)
+
+	// failed transfers don't claim the tokens
+	suite.app.ClaimsKeeper.OnAcknowledgementPacket(suite.ctx, packet, channeltypes.NewErrorAcknowledgement("failed").Acknowledgement())
+	suite.Require().Equal(int64(200), suite.balanceOf(suite.recipient))
+
+	suite.app.ClaimsKeeper.OnAcknowledgementPacket(suite.ctx, packet, channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement())
+	suite.Require().Equal(int64(300), suite.balanceOf(suite.recipient))
+
+	claimsRecord, found := suite.app.ClaimsKeeper.GetClaimsRecord(suite.ctx, suite.recipient)
+	suite.Require().True(found)
+	suite.Require().Equal([]bool{true, false, true, true}, claimsRecord.ActionsCompleted)
+}
+
+func (suite *KeeperTestSuite) TestClawbackUnclaimed() {
+	_, err := suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, suite.recipient, types.ACTION_VOTE)
+	suite.Require().NoError(err)
+
+	communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+	suite.Require().NoError(suite.app.ClaimsKeeper.ClawbackUnclaimed(suite.ctx))
+
+	moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.Require().Equal(int64(0), suite.balanceOf(moduleAddr))
+	suite.Require().Empty(suite.app.ClaimsKeeper.GetClaimsRecords(suite.ctx))
+	suite.Require().False(suite.app.ClaimsKeeper.GetParams(suite.ctx).EnableClaims)
+
+	clawedBack := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).Sub(communityPool)
+	suite.Require().Equal(int64(900), clawedBack.AmountOf(suite.params.ClaimsDenom).TruncateInt64())
+}
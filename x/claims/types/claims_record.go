@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Actions defines the list of actions that unlock the claimable tokens, each
+// action unlocking an equal part of the initial claimable amount
+var Actions = []Action{ACTION_VOTE, ACTION_DELEGATE, ACTION_EVM, ACTION_IBC_TRANSFER}
+
+// IsValid returns true if the action is one of the claimable actions
+func (a Action) IsValid() bool {
+	return a != ACTION_UNSPECIFIED && int(a) <= len(Actions)
+}
+
+// NewClaimsRecord creates a new claims record instance with no completed
+// actions
+func NewClaimsRecord(initialClaimableAmt sdk.Int) ClaimsRecord {
+	return ClaimsRecord{
+		InitialClaimableAmount: initialClaimableAmt,
+		ActionsCompleted:       make([]bool, len(Actions)),
+	}
+}
+
+// Validate performs a stateless validation of the claims record
+func (cr ClaimsRecord) Validate() error {
+	if cr.InitialClaimableAmount.IsNil() || !cr.InitialClaimableAmount.IsPositive() {
+		return fmt.Errorf("initial claimable amount must be positive: %s", cr.InitialClaimableAmount)
+	}
+
+	if len(cr.ActionsCompleted) != len(Actions) {
+		return fmt.Errorf("claims record must have %d actions, got %d", len(Actions), len(cr.ActionsCompleted))
+	}
+
+	return nil
+}
+
+// MarkClaimed sets the given action as completed
+func (cr *ClaimsRecord) MarkClaimed(action Action) {
+	cr.ActionsCompleted[action-1] = true
+}
+
+// HasClaimedAction returns true if the given action has been completed
+func (cr ClaimsRecord) HasClaimedAction(action Action) bool {
+	return cr.ActionsCompleted[action-1]
+}
+
+// HasClaimedAll returns true if all the actions have been completed
+func (cr ClaimsRecord) HasClaimedAll() bool {
+	for _, completed := range cr.ActionsCompleted {
+		if !completed {
+			return false
+		}
+	}
+
+	return true
+}
+
+// NewClaimsRecordAddress creates a new claims record instance with the
+// recipient address
+func NewClaimsRecordAddress(address sdk.AccAddress, cr ClaimsRecord) ClaimsRecordAddress {
+	return ClaimsRecordAddress{
+		Address:                address.String(),
+		InitialClaimableAmount: cr.InitialClaimableAmount,
+		ActionsCompleted:       cr.ActionsCompleted,
+	}
+}
+
+// GetClaimsRecord returns the claims record of the recipient
+func (cra ClaimsRecordAddress) GetClaimsRecord() ClaimsRecord {
+	return ClaimsRecord{
+		InitialClaimableAmount: cra.InitialClaimableAmount,
+		ActionsCompleted:       cra.ActionsCompleted,
+	}
+}
+
+// Validate performs a stateless validation of the claims record address
+func (cra ClaimsRecordAddress) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(cra.Address); err != nil {
+		return err
+	}
+
+	return cra.GetClaimsRecord().Validate()
+}
@@ -0,0 +1,9 @@
+package types
+
+// claims events
+const (
+	EventTypeClaim    = "claim"
+	EventTypeClawback = "clawback"
+
+	AttributeKeyActionType = "action"
+)
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, claimsRecords []ClaimsRecordAddress) GenesisState {
+	return GenesisState{
+		Params:        params,
+		ClaimsRecords: claimsRecords,
+	}
+}
+
+// DefaultGenesisState returns the default claims module genesis state with no
+// claims records.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenClaims := make(map[string]bool)
+
+	for _, claimsRecord := range gs.ClaimsRecords {
+		if seenClaims[claimsRecord.Address] {
+			return fmt.Errorf("duplicated claims record for address '%s'", claimsRecord.Address)
+		}
+
+		if err := claimsRecord.Validate(); err != nil {
+			return err
+		}
+
+		seenClaims[claimsRecord.Address] = true
+	}
+
+	return gs.Params.Validate()
+}
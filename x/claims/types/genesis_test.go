@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	addr := sdk.AccAddress(common.HexToAddress("0x01").Bytes())
+	claimsRecord := NewClaimsRecordAddress(addr, NewClaimsRecord(sdk.NewInt(100)))
+
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expPass  bool
+	}{
+		{"default", DefaultGenesisState(), true},
+		{"valid claims record", &GenesisState{Params: DefaultParams(), ClaimsRecords: []ClaimsRecordAddress{claimsRecord}}, true},
+		{
+			"duplicated claims record",
+			&GenesisState{Params: DefaultParams(), ClaimsRecords: []ClaimsRecordAddress{claimsRecord, claimsRecord}},
+			false,
+		},
+		{
+			"invalid address",
+			&GenesisState{Params: DefaultParams(), ClaimsRecords: []ClaimsRecordAddress{{Address: "invalid", InitialClaimableAmount: sdk.NewInt(100), ActionsCompleted: make([]bool, len(Actions))}}},
+			false,
+		},
+		{
+			"zero claimable amount",
+			&GenesisState{Params: DefaultParams(), ClaimsRecords: []ClaimsRecordAddress{NewClaimsRecordAddress(addr, NewClaimsRecord(sdk.ZeroInt()))}},
+			false,
+		},
+		{
+			"invalid number of actions",
+			&GenesisState{Params: DefaultParams(), ClaimsRecords: []ClaimsRecordAddress{{Address: addr.String(), InitialClaimableAmount: sdk.NewInt(100), ActionsCompleted: []bool{true}}}},
+			false,
+		},
+		{
+			"invalid denom",
+			&GenesisState{Params: Params{ClaimsDenom: "", DurationUntilDecay: DefaultDurationUntilDecay, DurationOfDecay: DefaultDurationOfDecay}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
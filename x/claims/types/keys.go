@@ -0,0 +1,23 @@
+package types
+
+// constants
+const (
+	// module name
+	ModuleName = "claims"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the claims persistent store
+const (
+	prefixClaimsRecords = iota + 1
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixClaimsRecords = []byte{prefixClaimsRecords}
+)
@@ -0,0 +1,156 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	ethermint "github.com/tharsis/ethermint/types"
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// Parameter store key
+var (
+	ParamStoreKeyEnableClaims       = []byte("EnableClaims")
+	ParamStoreKeyAirdropStartTime   = []byte("AirdropStartTime")
+	ParamStoreKeyDurationUntilDecay = []byte("DurationUntilDecay")
+	ParamStoreKeyDurationOfDecay    = []byte("DurationOfDecay")
+	ParamStoreKeyClaimsDenom        = []byte("ClaimsDenom")
+)
+
+// default parameters
+var (
+	DefaultClaimsDenom        = ethermint.AttoPhoton
+	DefaultDurationUntilDecay = 2629800 * time.Second // 1 month = 30.4375 days
+	DefaultDurationOfDecay    = 2 * DefaultDurationUntilDecay
+)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(
+	enableClaim bool,
+	claimsDenom string,
+	airdropStartTime time.Time,
+	durationUntilDecay,
+	durationOfDecay time.Duration,
+) Params {
+	return Params{
+		EnableClaims:       enableClaim,
+		ClaimsDenom:        claimsDenom,
+		AirdropStartTime:   airdropStartTime,
+		DurationUntilDecay: durationUntilDecay,
+		DurationOfDecay:    durationOfDecay,
+	}
+}
+
+// DefaultParams returns default claims module parameters
+func DefaultParams() Params {
+	return Params{
+		EnableClaims:       true,
+		ClaimsDenom:        DefaultClaimsDenom,
+		AirdropStartTime:   time.Time{},
+		DurationUntilDecay: DefaultDurationUntilDecay,
+		DurationOfDecay:    DefaultDurationOfDecay,
+	}
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyEnableClaims, &p.EnableClaims, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyAirdropStartTime, &p.AirdropStartTime, validateStartDate),
+		paramtypes.NewParamSetPair(ParamStoreKeyDurationUntilDecay, &p.DurationUntilDecay, validateDuration),
+		paramtypes.NewParamSetPair(ParamStoreKeyDurationOfDecay, &p.DurationOfDecay, validateDuration),
+		paramtypes.NewParamSetPair(ParamStoreKeyClaimsDenom, &p.ClaimsDenom, validateDenom),
+	}
+}
+
+// Validate performs a stateless validation of the claims module parameters.
+func (p Params) Validate() error {
+	if err := validateBool(p.EnableClaims); err != nil {
+		return err
+	}
+
+	if err := validateStartDate(p.AirdropStartTime); err != nil {
+		return err
+	}
+
+	if err := validateDuration(p.DurationUntilDecay); err != nil {
+		return err
+	}
+
+	if err := validateDuration(p.DurationOfDecay); err != nil {
+		return err
+	}
+
+	return validateDenom(p.ClaimsDenom)
+}
+
+// DecayStartTime returns the time at which the decay of the claimable amounts
+// starts
+func (p Params) DecayStartTime() time.Time {
+	return p.AirdropStartTime.Add(p.DurationUntilDecay)
+}
+
+// AirdropEndTime returns the time at which the airdrop ends and the unclaimed
+// tokens are clawed back to the community pool
+func (p Params) AirdropEndTime() time.Time {
+	return p.DecayStartTime().Add(p.DurationOfDecay)
+}
+
+// IsClaimsActive returns true if the claims are enabled and the block time is
+// within the airdrop period
+func (p Params) IsClaimsActive(blockTime time.Time) bool {
+	if !p.EnableClaims || blockTime.Before(p.AirdropStartTime) {
+		return false
+	}
+
+	return blockTime.Before(p.AirdropEndTime())
+}
+
+func validateBool(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateStartDate(i interface{}) error {
+	_, ok := i.(time.Time)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("duration must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateDenom(i interface{}) error {
+	denom, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return sdk.ValidateDenom(denom)
+}
@@ -0,0 +1,74 @@
+package deployment
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/tharsis/evmos/x/deployment/keeper"
+	"github.com/tharsis/evmos/x/deployment/types"
+)
+
+// DeploymentDecorator rejects the Ethereum transactions that create a contract
+// when the permissioned deployment is enabled, unless the sender or the hash
+// of the init code is allowed. Calls to existing contracts are not
+// restricted.
+//
+// NOTE: only the contract creation transactions are checked here. The
+// contracts created by other contracts with CREATE or CREATE2, e.g. by
+// factories or constructors, are checked by the deployment EVM post
+// transaction hook, which only allows the creating contracts listed in the
+// allowed deployers.
+type DeploymentDecorator struct {
+	keeper keeper.Keeper
+}
+
+// NewDeploymentDecorator creates a new DeploymentDecorator
+func NewDeploymentDecorator(k keeper.Keeper) DeploymentDecorator {
+	return DeploymentDecorator{
+		keeper: k,
+	}
+}
+
+// AnteHandle checks the contract creations after the next AnteHandler, as the
+// sender of the Ethereum transactions is only set once their signature has
+// been verified by the Ethermint AnteHandler.
+func (dd DeploymentDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	newCtx, err := next(ctx, tx, simulate)
+	if err != nil {
+		return newCtx, err
+	}
+
+	params := dd.keeper.GetParams(ctx)
+	if !params.EnablePermissionedDeployment {
+		return newCtx, nil
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			continue
+		}
+
+		ethTx := msgEthTx.AsTransaction()
+		if ethTx.To() != nil {
+			continue
+		}
+
+		deployer := common.HexToAddress(msgEthTx.From)
+		codeHash := crypto.Keccak256Hash(ethTx.Data())
+
+		if !params.IsDeploymentAllowed(deployer, codeHash) {
+			return ctx, sdkerrors.Wrapf(
+				types.ErrDeploymentNotAllowed,
+				"%s is not allowed to deploy contracts with init code hash %s", deployer, codeHash,
+			)
+		}
+	}
+
+	return newCtx, nil
+}
@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/tharsis/evmos/x/deployment/types"
+)
+
+// GetQueryCmd returns the parent command for all deployment CLI query commands
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the deployment module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetDeploymentAllowedCmd(),
+		GetParamsCmd(),
+	)
+	return cmd
+}
+
+// GetDeploymentAllowedCmd queries if a deployer is allowed to deploy contracts
+func GetDeploymentAllowedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deployment-allowed [deployer] [code-hash]",
+		Short: "Checks if an address is allowed to deploy contracts",
+		Long:  "Checks if a hex address is allowed to deploy any contract, or the init code with the given optional Keccak-256 hash",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDeploymentAllowedRequest{
+				Deployer: args[0],
+			}
+
+			if len(args) == 2 {
+				req.CodeHash = args[1]
+			}
+
+			res, err := queryClient.DeploymentAllowed(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetParamsCmd queries the module parameters
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Gets deployment params",
+		Long:  "Gets deployment params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryParamsRequest{}
+
+			res, err := queryClient.Params(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	ethermint "github.com/tharsis/ethermint/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/tharsis/evmos/x/deployment/types"
+)
+
+// PostTxProcessing implements the EVM post transaction hook. When the
+// permissioned deployment is enabled, it rejects the Ethereum transactions in
+// which a contract that isn't an allowed deployer created a contract with
+// CREATE or CREATE2, e.g. through a factory. The contract creation
+// transactions themselves are checked by the DeploymentDecorator.
+func (k Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	params := k.GetParams(ctx)
+	if !params.EnablePermissionedDeployment {
+		return nil
+	}
+
+	for _, creator := range k.GetContractCreators(ctx, receipt.TxHash) {
+		if creator == msg.From() {
+			continue
+		}
+
+		if !params.IsDeployerAllowed(creator) {
+			return sdkerrors.Wrapf(types.ErrDeploymentNotAllowed, "contract %s is not allowed to create contracts", creator)
+		}
+	}
+
+	return nil
+}
+
+// GetContractCreators returns the accounts that created contracts during the
+// given Ethereum transaction.
+func (k Keeper) GetContractCreators(ctx sdk.Context, txHash common.Hash) []common.Address {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.GetContractCreatorPrefix(txHash))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var creators []common.Address
+	for ; iterator.Valid(); iterator.Next() {
+		creators = append(creators, common.BytesToAddress(iterator.Key()))
+	}
+
+	return creators
+}
+
+// setContractCreator records an account that created a contract during the
+// given Ethereum transaction.
+func (k Keeper) setContractCreator(ctx sdk.Context, txHash common.Hash, creator common.Address) {
+	ctx.TransientStore(k.transientKey).Set(types.GetContractCreatorKey(txHash, creator), []byte{1})
+}
+
+var _ evmtypes.AccountKeeper = EVMAccountKeeper{}
+
+// EVMAccountKeeper wraps the account keeper of the EVM keeper to record the
+// accounts that create contracts. As go-ethereum increases the nonce of the
+// creating account on each CREATE and CREATE2, and the nonce of a contract
+// only changes when it creates a contract, an increased nonce identifies the
+// creator. The records are written on the context of the EVM state, so that
+// they are dropped along with the contracts created by a reverted call.
+type EVMAccountKeeper struct {
+	evmtypes.AccountKeeper
+	keeper          Keeper
+	evmTransientKey sdk.StoreKey
+}
+
+// NewEVMAccountKeeper creates a new EVMAccountKeeper. The EVM transient store
+// key is used to retrieve the hash of the Ethereum transaction being applied.
+func NewEVMAccountKeeper(ak evmtypes.AccountKeeper, k Keeper, evmTransientKey sdk.StoreKey) EVMAccountKeeper {
+	return EVMAccountKeeper{
+		AccountKeeper:   ak,
+		keeper:          k,
+		evmTransientKey: evmTransientKey,
+	}
+}
+
+// SetAccount records the account as a contract creator if its nonce has been
+// increased, unless it is a contract being created, whose nonce is set to 1,
+// before setting it.
+func (ak EVMAccountKeeper) SetAccount(ctx sdk.Context, account authtypes.AccountI) {
+	if prev := ak.AccountKeeper.GetAccount(ctx, account.GetAddress()); prev != nil &&
+		account.GetSequence() > prev.GetSequence() &&
+		(prev.GetSequence() > 0 || hasCode(prev)) {
+		txHash := common.BytesToHash(ctx.TransientStore(ak.evmTransientKey).Get(evmtypes.KeyPrefixTransientTxHash))
+		ak.keeper.setContractCreator(ctx, txHash, common.BytesToAddress(account.GetAddress()))
+	}
+
+	ak.AccountKeeper.SetAccount(ctx, account)
+}
+
+// hasCode returns true if the account is a contract account.
+func hasCode(account authtypes.AccountI) bool {
+	ethAccount, ok := account.(*ethermint.EthAccount)
+	return ok && ethAccount.GetCodeHash() != common.BytesToHash(evmtypes.EmptyCodeHash)
+}
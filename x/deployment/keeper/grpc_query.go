@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ethermint "github.com/tharsis/ethermint/types"
+
+	"github.com/tharsis/evmos/x/deployment/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// DeploymentAllowed returns whether a deployer is allowed to deploy the init
+// code with the given hash. Without a code hash, it returns whether the
+// deployer is allowed to deploy any contract.
+func (k Keeper) DeploymentAllowed(c context.Context, req *types.QueryDeploymentAllowedRequest) (*types.QueryDeploymentAllowedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ethermint.ValidateAddress(req.Deployer); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid format for deployer %s, should be hex ('0x...')", req.Deployer)
+	}
+
+	var codeHash common.Hash
+	if req.CodeHash != "" {
+		bz, err := hexutil.Decode(req.CodeHash)
+		if err != nil || len(bz) != common.HashLength {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid format for code hash %s, should be a 32 bytes hex ('0x...')", req.CodeHash)
+		}
+		codeHash = common.BytesToHash(bz)
+	}
+
+	allowed := k.GetParams(ctx).IsDeploymentAllowed(common.HexToAddress(req.Deployer), codeHash)
+	return &types.QueryDeploymentAllowedResponse{Allowed: allowed}, nil
+}
+
+// Params returns the deployment module parameters
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
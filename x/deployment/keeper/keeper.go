@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/tharsis/evmos/x/deployment/types"
+)
+
+// Keeper of this module maintains the governance-managed permissions to
+// deploy contracts.
+type Keeper struct {
+	transientKey sdk.StoreKey
+	paramstore   paramtypes.Subspace
+}
+
+// NewKeeper creates new instances of the deployment Keeper
+func NewKeeper(transientKey sdk.StoreKey, ps paramtypes.Subspace) Keeper {
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		transientKey: transientKey,
+		paramstore:   ps,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+	"github.com/tharsis/ethermint/tests"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/tharsis/evmos/app"
+	"github.com/tharsis/evmos/x/deployment"
+	"github.com/tharsis/evmos/x/deployment/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *app.Evmos
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	// consensus key
+	consPriv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	consAddress := sdk.ConsAddress(consPriv.PubKey().Address())
+
+	suite.app = app.Setup(false, nil)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Height:          1,
+		ChainID:         "evmos_9000-1",
+		Time:            time.Now().UTC(),
+		ProposerAddress: consAddress.Bytes(),
+	})
+	suite.app.EvmKeeper.WithChainID(suite.ctx)
+
+	// the EVM requires the block proposer to be a validator
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(tests.GenerateAddress().Bytes()), consPriv.PubKey(), stakingtypes.Description{})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.app.StakingKeeper.SetValidatorByConsAddr(suite.ctx, validator))
+	suite.app.StakingKeeper.SetValidator(suite.ctx, validator)
+}
+
+func (suite *KeeperTestSuite) TestDeploymentDecorator() {
+	deployer := tests.GenerateAddress()
+	other := tests.GenerateAddress()
+	contract := tests.GenerateAddress()
+	allowedCode := []byte("allowed code")
+
+	suite.app.DeploymentKeeper.SetParams(suite.ctx, types.NewParams(
+		true, []string{deployer.Hex()}, []string{crypto.Keccak256Hash(allowedCode).Hex()},
+	))
+
+	decorator := deployment.NewDeploymentDecorator(suite.app.DeploymentKeeper)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	newTx := func(from common.Address, to *common.Address, data []byte) sdk.Tx {
+		msg := evmtypes.NewTx(big.NewInt(9000), 0, to, nil, 100000, big.NewInt(1), nil, nil, data, nil)
+		msg.From = from.Hex()
+		return &testTx{msgs: []sdk.Msg{msg}}
+	}
+
+	testCases := []struct {
+		name    string
+		tx      sdk.Tx
+		expPass bool
+	}{
+		{"allowed deployer", newTx(deployer, nil, []byte("code")), true},
+		{"allowed init code", newTx(other, nil, allowedCode), true},
+		{"contract call", newTx(other, &contract, []byte("code")), true},
+		{"not allowed", newTx(other, nil, []byte("code")), false},
+	}
+
+	for _, tc := range testCases {
+		_, err := decorator.AnteHandle(suite.ctx, tc.tx, false, next)
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().ErrorIs(err, types.ErrDeploymentNotAllowed, tc.name)
+		}
+	}
+
+	// permissionless deployments
+	suite.app.DeploymentKeeper.SetParams(suite.ctx, types.DefaultParams())
+	_, err := decorator.AnteHandle(suite.ctx, newTx(other, nil, []byte("code")), false, next)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestFactoryDeployment() {
+	sender, privKey := tests.NewAddrKey()
+	factory := tests.GenerateAddress()
+	reverter := tests.GenerateAddress()
+
+	// the factory creates an empty contract, the reverter also reverts
+	createCode := []byte{byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CREATE), byte(vm.POP)}
+	suite.app.EvmKeeper.WithContext(suite.ctx)
+	suite.app.EvmKeeper.SetCode(factory, append(createCode, byte(vm.STOP)))
+	suite.app.EvmKeeper.SetCode(reverter, append(createCode, byte(vm.PUSH1), 0, byte(vm.DUP1), byte(vm.REVERT)))
+
+	hooks := app.NewEVMHooks().AddHook(types.ModuleName, suite.app.DeploymentKeeper)
+	handler := app.NewEVMAppModule(
+		suite.app.EvmKeeper, suite.app.AccountKeeper, suite.app.FeeMarketKeeper,
+		suite.app.EvmFeeGrantKeeper, suite.app.SponsorshipKeeper, hooks,
+	).Route().Handler()
+	nonce := uint64(0)
+	call := func(contract common.Address) (*evmtypes.MsgEthereumTxResponse, error) {
+		chainID := suite.app.EvmKeeper.ChainID()
+		msg := evmtypes.NewTx(chainID, nonce, &contract, nil, 100000, big.NewInt(0), nil, nil, nil, nil)
+		msg.From = sender.Hex()
+		suite.Require().NoError(msg.Sign(ethtypes.LatestSignerForChainID(chainID), tests.NewSigner(privKey)))
+		nonce++
+
+		ctx, _ := suite.ctx.CacheContext()
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+
+		var txRes evmtypes.MsgEthereumTxResponse
+		suite.Require().NoError(suite.app.AppCodec().Unmarshal(res.Data, &txRes))
+		return &txRes, nil
+	}
+
+	// the contracts created by a factory are allowed when the deployment is
+	// permissionless
+	_, err := call(factory)
+	suite.Require().NoError(err)
+
+	// the factory can't bypass the permissioned deployment
+	suite.app.DeploymentKeeper.SetParams(suite.ctx, types.NewParams(true, []string{sender.Hex()}, []string{}))
+	_, err = call(factory)
+	suite.Require().ErrorIs(err, types.ErrDeploymentNotAllowed)
+
+	// the contracts created in a reverted call are ignored
+	res, err := call(reverter)
+	suite.Require().NoError(err)
+	suite.Require().True(res.Failed())
+
+	// allowed factory
+	suite.app.DeploymentKeeper.SetParams(suite.ctx, types.NewParams(true, []string{factory.Hex()}, []string{}))
+	_, err = call(factory)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestDeploymentAllowedQuery() {
+	deployer := tests.GenerateAddress()
+	suite.app.DeploymentKeeper.SetParams(suite.ctx, types.NewParams(true, []string{deployer.Hex()}, []string{}))
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	res, err := suite.app.DeploymentKeeper.DeploymentAllowed(ctx, &types.QueryDeploymentAllowedRequest{Deployer: deployer.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Allowed)
+
+	codeHash := crypto.Keccak256Hash([]byte("code")).Hex()
+	res, err = suite.app.DeploymentKeeper.DeploymentAllowed(ctx, &types.QueryDeploymentAllowedRequest{Deployer: tests.GenerateAddress().Hex(), CodeHash: codeHash})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Allowed)
+
+	_, err = suite.app.DeploymentKeeper.DeploymentAllowed(ctx, &types.QueryDeploymentAllowedRequest{Deployer: "evmos1"})
+	suite.Require().Error(err)
+	_, err = suite.app.DeploymentKeeper.DeploymentAllowed(ctx, &types.QueryDeploymentAllowedRequest{Deployer: deployer.Hex(), CodeHash: "0x12"})
+	suite.Require().Error(err)
+}
+
+type testTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx *testTx) GetMsgs() []sdk.Msg   { return tx.msgs }
+func (tx *testTx) ValidateBasic() error { return nil }
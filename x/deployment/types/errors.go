@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// errors
+var (
+	ErrDeploymentNotAllowed = sdkerrors.Register(ModuleName, 2, "contract deployment not allowed")
+)
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	deployer := "0x2cc7fdf9fde6746731d7f11979609d455c2c197a"
+	codeHash := crypto.Keccak256Hash([]byte("code")).Hex()
+
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expPass  bool
+	}{
+		{"default", DefaultGenesisState(), true},
+		{"allowed deployers and code hashes", &GenesisState{Params: NewParams(true, []string{deployer}, []string{codeHash})}, true},
+		{"invalid deployer", &GenesisState{Params: NewParams(true, []string{"evmos1"}, []string{})}, false},
+		{"duplicated deployer", &GenesisState{Params: NewParams(true, []string{deployer, common.HexToAddress(deployer).Hex()}, []string{})}, false},
+		{"invalid code hash", &GenesisState{Params: NewParams(true, []string{}, []string{"0x1234"})}, false},
+		{"non hex code hash", &GenesisState{Params: NewParams(true, []string{}, []string{"code"})}, false},
+		{"duplicated code hash", &GenesisState{Params: NewParams(true, []string{}, []string{codeHash, codeHash})}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestIsDeploymentAllowed(t *testing.T) {
+	deployer := common.HexToAddress("0x2cc7fdf9fde6746731d7f11979609d455c2c197a")
+	other := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	codeHash := crypto.Keccak256Hash([]byte("code"))
+	otherHash := crypto.Keccak256Hash([]byte("other"))
+
+	require.True(t, DefaultParams().IsDeploymentAllowed(other, otherHash))
+
+	params := NewParams(true, []string{deployer.Hex()}, []string{codeHash.Hex()})
+	require.True(t, params.IsDeploymentAllowed(deployer, otherHash))
+	require.True(t, params.IsDeploymentAllowed(other, codeHash))
+	require.False(t, params.IsDeploymentAllowed(other, otherHash))
+}
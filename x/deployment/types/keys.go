@@ -0,0 +1,38 @@
+package types
+
+import "github.com/ethereum/go-ethereum/common"
+
+// constants
+const (
+	// module name
+	ModuleName = "deployment"
+
+	// TransientKey is the key to access the deployment transient store, that
+	// is reset during the Commit phase.
+	TransientKey = "transient_" + ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the deployment transient store
+const (
+	prefixTransientContractCreator = iota + 1
+)
+
+// Transient Store key prefixes
+var (
+	KeyPrefixTransientContractCreator = []byte{prefixTransientContractCreator}
+)
+
+// GetContractCreatorPrefix returns the transient store prefix of the contracts
+// that created contracts during the given Ethereum transaction
+func GetContractCreatorPrefix(txHash common.Hash) []byte {
+	return append(KeyPrefixTransientContractCreator, txHash.Bytes()...)
+}
+
+// GetContractCreatorKey returns the transient store key of a contract that
+// created contracts during the given Ethereum transaction
+func GetContractCreatorKey(txHash common.Hash, creator common.Address) []byte {
+	return append(GetContractCreatorPrefix(txHash), creator.Bytes()...)
+}
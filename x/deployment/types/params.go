@@ -0,0 +1,162 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	ethermint "github.com/tharsis/ethermint/types"
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// Parameter store key
+var (
+	ParamStoreKeyEnablePermissionedDeployment = []byte("EnablePermissionedDeployment")
+	ParamStoreKeyAllowedDeployers             = []byte("AllowedDeployers")
+	ParamStoreKeyAllowedCodeHashes            = []byte("AllowedCodeHashes")
+)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(enablePermissionedDeployment bool, allowedDeployers, allowedCodeHashes []string) Params {
+	return Params{
+		EnablePermissionedDeployment: enablePermissionedDeployment,
+		AllowedDeployers:             allowedDeployers,
+		AllowedCodeHashes:            allowedCodeHashes,
+	}
+}
+
+// DefaultParams returns default deployment module parameters. The contract
+// deployments are permissionless by default.
+func DefaultParams() Params {
+	return Params{
+		EnablePermissionedDeployment: false,
+		AllowedDeployers:             []string{},
+		AllowedCodeHashes:            []string{},
+	}
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyEnablePermissionedDeployment, &p.EnablePermissionedDeployment, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyAllowedDeployers, &p.AllowedDeployers, validateDeployers),
+		paramtypes.NewParamSetPair(ParamStoreKeyAllowedCodeHashes, &p.AllowedCodeHashes, validateCodeHashes),
+	}
+}
+
+// Validate performs a stateless validation of the deployment module
+// parameters.
+func (p Params) Validate() error {
+	if err := validateBool(p.EnablePermissionedDeployment); err != nil {
+		return err
+	}
+
+	if err := validateDeployers(p.AllowedDeployers); err != nil {
+		return err
+	}
+
+	return validateCodeHashes(p.AllowedCodeHashes)
+}
+
+// IsDeploymentAllowed returns true if the permissioned deployment is disabled,
+// or if either the deployer or the init code hash is allowed.
+func (p Params) IsDeploymentAllowed(deployer common.Address, codeHash common.Hash) bool {
+	if p.IsDeployerAllowed(deployer) {
+		return true
+	}
+
+	for _, allowed := range p.AllowedCodeHashes {
+		if common.HexToHash(allowed) == codeHash {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsDeployerAllowed returns true if the permissioned deployment is disabled,
+// or if the deployer is allowed. The contracts created by other contracts are
+// only checked against the allowed deployers, as their init code isn't known
+// outside of the EVM.
+func (p Params) IsDeployerAllowed(deployer common.Address) bool {
+	if !p.EnablePermissionedDeployment {
+		return true
+	}
+
+	for _, allowed := range p.AllowedDeployers {
+		if common.HexToAddress(allowed) == deployer {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateBool(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateDeployers(i interface{}) error {
+	deployers, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[common.Address]bool)
+	for _, deployer := range deployers {
+		if err := ethermint.ValidateAddress(deployer); err != nil {
+			return fmt.Errorf("invalid allowed deployer: %w", err)
+		}
+
+		address := common.HexToAddress(deployer)
+		if seen[address] {
+			return fmt.Errorf("duplicated allowed deployer %s", deployer)
+		}
+
+		seen[address] = true
+	}
+
+	return nil
+}
+
+func validateCodeHashes(i interface{}) error {
+	codeHashes, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[common.Hash]bool)
+	for _, codeHash := range codeHashes {
+		bz, err := hexutil.Decode(codeHash)
+		if err != nil {
+			return fmt.Errorf("invalid allowed code hash %s: %w", codeHash, err)
+		}
+
+		if len(bz) != common.HashLength {
+			return fmt.Errorf("invalid allowed code hash %s: expected %d bytes, got %d", codeHash, common.HashLength, len(bz))
+		}
+
+		hash := common.BytesToHash(bz)
+		if seen[hash] {
+			return fmt.Errorf("duplicated allowed code hash %s", codeHash)
+		}
+
+		seen[hash] = true
+	}
+
+	return nil
+}
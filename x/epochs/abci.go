@@ -0,0 +1,66 @@
+package epochs
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/x/epochs/keeper"
+	"github.com/tharsis/evmos/x/epochs/types"
+)
+
+// BeginBlocker starts the epochs whose start time has been reached and moves
+// the running epochs to the next one once their duration has elapsed, calling
+// the epoch hooks of the subscribing modules.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	// the epoch infos are collected first as the store must not be modified
+	// while iterating over it
+	for _, epoch := range k.AllEpochInfos(ctx) {
+		// the counting of the epochs hasn't started yet
+		if ctx.BlockTime().Before(epoch.StartTime) {
+			continue
+		}
+
+		if !epoch.EpochCountingStarted {
+			epoch.EpochCountingStarted = true
+			epoch.CurrentEpoch = 1
+			epoch.CurrentEpochStartTime = epoch.StartTime
+		} else {
+			// the current epoch is still running
+			if ctx.BlockTime().Before(epoch.EndTime()) {
+				continue
+			}
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeEpochEnd,
+					sdk.NewAttribute(types.AttributeEpochIdentifier, epoch.Identifier),
+					sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epoch.CurrentEpoch, 10)),
+				),
+			)
+
+			k.AfterEpochEnd(ctx, epoch.Identifier, epoch.CurrentEpoch)
+
+			epoch.CurrentEpoch++
+			epoch.CurrentEpochStartTime = epoch.EndTime()
+		}
+
+		epoch.CurrentEpochStartHeight = ctx.BlockHeight()
+		k.SetEpochInfo(ctx, epoch)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEpochStart,
+				sdk.NewAttribute(types.AttributeEpochIdentifier, epoch.Identifier),
+				sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epoch.CurrentEpoch, 10)),
+				sdk.NewAttribute(types.AttributeEpochStartTime, epoch.CurrentEpochStartTime.String()),
+			),
+		)
+
+		k.BeforeEpochStart(ctx, epoch.Identifier, epoch.CurrentEpoch)
+	}
+}
@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/tharsis/evmos/x/epochs/types"
+)
+
+// GetQueryCmd returns the parent command for all epochs CLI query commands
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the epochs module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetEpochInfosCmd(),
+		GetCurrentEpochCmd(),
+	)
+	return cmd
+}
+
+// GetEpochInfosCmd queries all the tracked epochs
+func GetEpochInfosCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-infos",
+		Short: "Gets all the tracked epochs",
+		Long:  "Gets all the tracked epochs with their duration and current epoch",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryEpochsInfoRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.EpochInfos(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "epoch infos")
+	return cmd
+}
+
+// GetCurrentEpochCmd queries the current epoch of an identifier
+func GetCurrentEpochCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "current-epoch [identifier]",
+		Short: "Gets the current epoch of an identifier",
+		Long:  "Gets the current epoch number of an identifier (eg: day, week)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCurrentEpochRequest{
+				Identifier: args[0],
+			}
+
+			res, err := queryClient.CurrentEpoch(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,32 @@
+package epochs
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/x/epochs/keeper"
+	"github.com/tharsis/evmos/x/epochs/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	for _, epoch := range data.Epochs {
+		// the epochs start at genesis time by default
+		if epoch.StartTime.IsZero() {
+			epoch.StartTime = ctx.BlockTime()
+		}
+
+		if !epoch.EpochCountingStarted {
+			epoch.CurrentEpochStartTime = epoch.StartTime
+		}
+
+		epoch.CurrentEpochStartHeight = ctx.BlockHeight()
+		k.SetEpochInfo(ctx, epoch)
+	}
+}
+
+// ExportGenesis export module status
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Epochs: k.AllEpochInfos(ctx),
+	}
+}
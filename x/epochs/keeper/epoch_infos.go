@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/x/epochs/types"
+)
+
+// GetEpochInfo returns the epoch info of the given identifier
+func (k Keeper) GetEpochInfo(ctx sdk.Context, identifier string) (types.EpochInfo, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpoch)
+	bz := store.Get([]byte(identifier))
+	if len(bz) == 0 {
+		return types.EpochInfo{}, false
+	}
+
+	var epoch types.EpochInfo
+	k.cdc.MustUnmarshal(bz, &epoch)
+	return epoch, true
+}
+
+// SetEpochInfo stores the epoch info
+func (k Keeper) SetEpochInfo(ctx sdk.Context, epoch types.EpochInfo) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpoch)
+	bz := k.cdc.MustMarshal(&epoch)
+	store.Set([]byte(epoch.Identifier), bz)
+}
+
+// DeleteEpochInfo removes the epoch info of the given identifier
+func (k Keeper) DeleteEpochInfo(ctx sdk.Context, identifier string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpoch)
+	store.Delete([]byte(identifier))
+}
+
+// IterateEpochInfo iterates over all the epoch infos and performs a callback
+// function
+func (k Keeper) IterateEpochInfo(ctx sdk.Context, handlerFn func(epoch types.EpochInfo) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpoch)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var epoch types.EpochInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &epoch)
+
+		if handlerFn(epoch) {
+			break
+		}
+	}
+}
+
+// AllEpochInfos returns all the epoch infos
+func (k Keeper) AllEpochInfos(ctx sdk.Context) []types.EpochInfo {
+	epochs := []types.EpochInfo{}
+
+	k.IterateEpochInfo(ctx, func(epoch types.EpochInfo) (stop bool) {
+		epochs = append(epochs, epoch)
+		return false
+	})
+
+	return epochs
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/x/epochs/types"
+)
+
+var _ types.EpochHooks = Keeper{}
+
+// AfterEpochEnd calls the AfterEpochEnd hook of the subscribing modules, if
+// any
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber int64) {
+	if k.hooks != nil {
+		k.hooks.AfterEpochEnd(ctx, identifier, epochNumber)
+	}
+}
+
+// BeforeEpochStart calls the BeforeEpochStart hook of the subscribing modules,
+// if any
+func (k Keeper) BeforeEpochStart(ctx sdk.Context, identifier string, epochNumber int64) {
+	if k.hooks != nil {
+		k.hooks.BeforeEpochStart(ctx, identifier, epochNumber)
+	}
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/tharsis/evmos/x/epochs/types"
+)
+
+// Keeper of this module maintains the tracked epochs and calls the epoch hooks
+// of the subscribing modules.
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryCodec
+	hooks    types.EpochHooks
+}
+
+// NewKeeper creates new instances of the epochs Keeper
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.BinaryCodec) *Keeper {
+	return &Keeper{
+		storeKey: storeKey,
+		cdc:      cdc,
+	}
+}
+
+// SetHooks sets the hooks called at the start and end of each epoch. It panics
+// if the hooks have already been set.
+func (k *Keeper) SetHooks(eh types.EpochHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set epochs hooks twice")
+	}
+
+	k.hooks = eh
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
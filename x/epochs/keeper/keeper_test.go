@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/app"
+	"github.com/tharsis/evmos/x/epochs"
+	"github.com/tharsis/evmos/x/epochs/keeper"
+	"github.com/tharsis/evmos/x/epochs/types"
+)
+
+// hookCall records a call to the epoch hooks
+type hookCall struct {
+	start      bool
+	identifier string
+	number     int64
+}
+
+// mockHooks records the calls to the epoch hooks
+type mockHooks struct {
+	calls []hookCall
+}
+
+func (h *mockHooks) AfterEpochEnd(_ sdk.Context, identifier string, number int64) {
+	h.calls = append(h.calls, hookCall{false, identifier, number})
+}
+
+func (h *mockHooks) BeforeEpochStart(_ sdk.Context, identifier string, number int64) {
+	h.calls = append(h.calls, hookCall{true, identifier, number})
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx    sdk.Context
+	app    *app.Evmos
+	keeper keeper.Keeper
+	hooks  *mockHooks
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.Setup(false, nil)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: "evmos_9000-1",
+		Time:    time.Now().UTC(),
+	})
+
+	// use a keeper on the app store with hooks recording the calls
+	suite.hooks = &mockHooks{}
+	suite.keeper = *keeper.NewKeeper(suite.app.GetKey(types.StoreKey), suite.app.AppCodec()).SetHooks(suite.hooks)
+}
+
+func (suite *KeeperTestSuite) TestEpochLifeCycle() {
+	// only track the test epoch
+	suite.keeper.DeleteEpochInfo(suite.ctx, types.DayEpochID)
+	suite.keeper.DeleteEpochInfo(suite.ctx, types.WeekEpochID)
+
+	startTime := suite.ctx.BlockTime().Add(time.Hour)
+	epoch := types.NewEpochInfo("hour", startTime, time.Hour)
+	suite.keeper.SetEpochInfo(suite.ctx, epoch)
+
+	// the epoch counting hasn't started yet
+	epochs.BeginBlocker(suite.ctx, suite.keeper)
+	suite.Require().Empty(suite.hooks.calls)
+
+	// the first epoch starts at the start time
+	suite.ctx = suite.ctx.WithBlockHeight(2).WithBlockTime(startTime)
+	epochs.BeginBlocker(suite.ctx, suite.keeper)
+	suite.Require().Equal([]hookCall{{true, "hour", 1}}, suite.hooks.calls)
+
+	epoch, found := suite.keeper.GetEpochInfo(suite.ctx, "hour")
+	suite.Require().True(found)
+	suite.Require().True(epoch.EpochCountingStarted)
+	suite.Require().Equal(int64(1), epoch.CurrentEpoch)
+	suite.Require().Equal(int64(2), epoch.CurrentEpochStartHeight)
+
+	// the first epoch is still running
+	suite.ctx = suite.ctx.WithBlockHeight(3).WithBlockTime(startTime.Add(30 * time.Minute))
+	epochs.BeginBlocker(suite.ctx, suite.keeper)
+	suite.Require().Len(suite.hooks.calls, 1)
+
+	// the first epoch ends and the second one starts
+	suite.ctx = suite.ctx.WithBlockHeight(4).WithBlockTime(startTime.Add(time.Hour + time.Minute))
+	epochs.BeginBlocker(suite.ctx, suite.keeper)
+	suite.Require().Equal([]hookCall{{true, "hour", 1}, {false, "hour", 1}, {true, "hour", 2}}, suite.hooks.calls)
+
+	epoch, _ = suite.keeper.GetEpochInfo(suite.ctx, "hour")
+	suite.Require().Equal(int64(2), epoch.CurrentEpoch)
+	suite.Require().Equal(startTime.Add(time.Hour), epoch.CurrentEpochStartTime)
+	suite.Require().Equal(int64(4), epoch.CurrentEpochStartHeight)
+
+	res, err := suite.keeper.CurrentEpoch(sdk.WrapSDKContext(suite.ctx), &types.QueryCurrentEpochRequest{Identifier: "hour"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(2), res.CurrentEpoch)
+
+	_, err = suite.keeper.CurrentEpoch(sdk.WrapSDKContext(suite.ctx), &types.QueryCurrentEpochRequest{Identifier: "minute"})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestDefaultGenesisEpochs() {
+	for _, identifier := range []string{types.DayEpochID, types.WeekEpochID} {
+		epoch, found := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, identifier)
+		suite.Require().True(found, identifier)
+		suite.Require().False(epoch.EpochCountingStarted, identifier)
+	}
+}
@@ -0,0 +1,10 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// ModuleCdc references the global epochs module codec. Note, the codec should
+// ONLY be used in certain instances of tests and for JSON encoding.
+var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// NewEpochInfo creates a new epoch info instance that starts counting at the
+// given time
+func NewEpochInfo(identifier string, startTime time.Time, duration time.Duration) EpochInfo {
+	return EpochInfo{
+		Identifier:            identifier,
+		StartTime:             startTime,
+		Duration:              duration,
+		CurrentEpoch:          0,
+		CurrentEpochStartTime: startTime,
+		EpochCountingStarted:  false,
+	}
+}
+
+// Validate performs a stateless validation of the epoch info
+func (ei EpochInfo) Validate() error {
+	if err := ValidateEpochIdentifierString(ei.Identifier); err != nil {
+		return err
+	}
+
+	if ei.Duration <= 0 {
+		return fmt.Errorf("epoch duration must be positive: %s", ei.Duration)
+	}
+
+	if ei.CurrentEpoch < 0 {
+		return fmt.Errorf("current epoch cannot be negative: %d", ei.CurrentEpoch)
+	}
+
+	if ei.CurrentEpochStartHeight < 0 {
+		return errors.New("current epoch start height cannot be negative")
+	}
+
+	return nil
+}
+
+// EndTime returns the time at which the current epoch ends
+func (ei EpochInfo) EndTime() time.Time {
+	return ei.CurrentEpochStartTime.Add(ei.Duration)
+}
@@ -0,0 +1,11 @@
+package types
+
+// epochs events
+const (
+	EventTypeEpochStart = "epoch_start"
+	EventTypeEpochEnd   = "epoch_end"
+
+	AttributeEpochIdentifier = "epoch_identifier"
+	AttributeEpochNumber     = "epoch_number"
+	AttributeEpochStartTime  = "start_time"
+)
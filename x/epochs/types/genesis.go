@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(epochs []EpochInfo) GenesisState {
+	return GenesisState{
+		Epochs: epochs,
+	}
+}
+
+// DefaultGenesisState returns the default epochs module genesis state with a
+// daily and a weekly epoch starting at genesis time.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Epochs: []EpochInfo{
+			NewEpochInfo(DayEpochID, time.Time{}, 24*time.Hour),
+			NewEpochInfo(WeekEpochID, time.Time{}, 7*24*time.Hour),
+		},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenIdentifiers := make(map[string]bool)
+
+	for _, epoch := range gs.Epochs {
+		if seenIdentifiers[epoch.Identifier] {
+			return fmt.Errorf("duplicated epoch identifier '%s'", epoch.Identifier)
+		}
+
+		if err := epoch.Validate(); err != nil {
+			return err
+		}
+
+		seenIdentifiers[epoch.Identifier] = true
+	}
+
+	return nil
+}
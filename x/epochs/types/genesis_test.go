@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expPass  bool
+	}{
+		{"default", DefaultGenesisState(), true},
+		{"empty", &GenesisState{}, true},
+		{
+			"duplicated identifier",
+			&GenesisState{Epochs: []EpochInfo{NewEpochInfo(DayEpochID, time.Time{}, time.Hour), NewEpochInfo(DayEpochID, time.Time{}, 24*time.Hour)}},
+			false,
+		},
+		{
+			"blank identifier",
+			&GenesisState{Epochs: []EpochInfo{NewEpochInfo(" ", time.Time{}, time.Hour)}},
+			false,
+		},
+		{
+			"zero duration",
+			&GenesisState{Epochs: []EpochInfo{NewEpochInfo(DayEpochID, time.Time{}, 0)}},
+			false,
+		},
+		{
+			"negative current epoch",
+			&GenesisState{Epochs: []EpochInfo{{Identifier: DayEpochID, Duration: time.Hour, CurrentEpoch: -1}}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EpochHooks defines the event hooks of the epochs module
+type EpochHooks interface {
+	// AfterEpochEnd is called when the given epoch ends
+	AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64)
+	// BeforeEpochStart is called when a new epoch starts
+	BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64)
+}
+
+var _ EpochHooks = MultiEpochHooks{}
+
+// MultiEpochHooks combines multiple epoch hooks, all hook functions are run in
+// array sequence
+type MultiEpochHooks []EpochHooks
+
+// NewMultiEpochHooks combines multiple epoch hooks
+func NewMultiEpochHooks(hooks ...EpochHooks) MultiEpochHooks {
+	return hooks
+}
+
+// AfterEpochEnd is called when the given epoch ends
+func (mh MultiEpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	for i := range mh {
+		mh[i].AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+	}
+}
+
+// BeforeEpochStart is called when a new epoch starts
+func (mh MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	for i := range mh {
+		mh[i].BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+	}
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// default epoch identifiers
+const (
+	DayEpochID  = "day"
+	WeekEpochID = "week"
+)
+
+// ValidateEpochIdentifierInterface performs a stateless validation of the
+// epoch identifier interface, used for the parameters of the modules that
+// subscribe to the epoch hooks.
+func ValidateEpochIdentifierInterface(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return ValidateEpochIdentifierString(v)
+}
+
+// ValidateEpochIdentifierString performs a stateless validation of the epoch
+// identifier.
+func ValidateEpochIdentifierString(s string) error {
+	if strings.TrimSpace(s) == "" {
+		return fmt.Errorf("blank epoch identifier: %s", s)
+	}
+
+	return nil
+}
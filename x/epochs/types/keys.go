@@ -0,0 +1,23 @@
+package types
+
+// constants
+const (
+	// module name
+	ModuleName = "epochs"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the epochs persistent store
+const (
+	prefixEpoch = iota + 1
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixEpoch = []byte{prefixEpoch}
+)
@@ -0,0 +1,284 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/tharsis/evmos/x/erc20/types"
+)
+
+// NewTxCmd returns a root CLI command handler for erc20 transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "erc20 subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewConvertCoinCmd(),
+		NewConvertERC20Cmd(),
+	)
+	return txCmd
+}
+
+// NewConvertCoinCmd returns a CLI command handler for converting a cosmos coin
+func NewConvertCoinCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-coin [coin] [receiver_hex]",
+		Short: "Convert a cosmos coin to an ERC20 token. When the receiver is omitted, the tokens are transferred to the sender.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			var receiver string
+			sender := cliCtx.GetFromAddress()
+
+			if len(args) == 2 {
+				receiver = args[1]
+				if !common.IsHexAddress(receiver) {
+					return fmt.Errorf("invalid receiver hex address %s", receiver)
+				}
+			} else {
+				receiver = common.BytesToAddress(sender).Hex()
+			}
+
+			msg := &types.MsgConvertCoin{
+				Coin:     coin,
+				Receiver: receiver,
+				Sender:   sender.String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewConvertERC20Cmd returns a CLI command handler for converting an ERC20 token
+func NewConvertERC20Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-erc20 [contract_address] [amount] [receiver]",
+		Short: "Convert an ERC20 token to Cosmos coin. When the receiver is omitted, the coins are transferred to the sender.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if !common.IsHexAddress(contract) {
+				return fmt.Errorf("invalid ERC20 contract address %s", contract)
+			}
+
+			amount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[1])
+			}
+
+			from := common.BytesToAddress(cliCtx.GetFromAddress().Bytes())
+
+			receiver := cliCtx.GetFromAddress()
+			if len(args) == 3 {
+				receiver, err = sdk.AccAddressFromBech32(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := &types.MsgConvertERC20{
+				ContractAddress: contract,
+				Amount:          amount,
+				Receiver:        receiver.String(),
+				Sender:          from.Hex(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRegisterCoinProposalCmd implements the command to submit a register-coin proposal
+func NewRegisterCoinProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register-coin [metadata]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a proposal to register a cosmos coin to the erc20 module along with an initial deposit. The coin metadata is provided as a JSON file.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal register-coin metadata.json --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := readProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			metadataFile, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var metadata banktypes.Metadata
+			if err := clientCtx.Codec.UnmarshalJSON(metadataFile, &metadata); err != nil {
+				return err
+			}
+
+			content := types.NewRegisterCoinProposal(title, description, metadata)
+
+			return submitProposal(cmd, clientCtx, content, deposit)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewRegisterERC20ProposalCmd implements the command to submit a register-erc20 proposal
+func NewRegisterERC20ProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register-erc20 [erc20-address]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a proposal to register an ERC20 token to the erc20 module along with an initial deposit.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal register-erc20 <contract_address> --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := readProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewRegisterERC20Proposal(title, description, args[0])
+
+			return submitProposal(cmd, clientCtx, content, deposit)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewToggleTokenConversionProposalCmd implements the command to submit a toggle-token-conversion proposal
+func NewToggleTokenConversionProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "toggle-token-conversion [token]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a toggle token conversion proposal for a registered token pair, identified by its ERC20 address or coin denomination.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal toggle-token-conversion <denom_or_contract> --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := readProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewToggleTokenConversionProposal(title, description, args[0])
+
+			return submitProposal(cmd, clientCtx, content, deposit)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// addProposalFlags adds the common governance proposal flags to the command
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(govcli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(govcli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(govcli.FlagDeposit); err != nil {
+		panic(err)
+	}
+}
+
+// readProposalFlags returns the title, description and deposit of a proposal
+func readProposalFlags(cmd *cobra.Command) (string, string, sdk.Coins, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	return title, description, deposit, nil
+}
+
+// submitProposal builds and broadcasts a MsgSubmitProposal for the given content
+func submitProposal(cmd *cobra.Command, clientCtx client.Context, content govtypes.Content, deposit sdk.Coins) error {
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
@@ -0,0 +1,17 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/tharsis/evmos/x/erc20/client/cli"
+	"github.com/tharsis/evmos/x/erc20/client/rest"
+)
+
+var (
+	// RegisterCoinProposalHandler is the CLI and REST handler for the register coin proposal
+	RegisterCoinProposalHandler = govclient.NewProposalHandler(cli.NewRegisterCoinProposalCmd, rest.RegisterCoinProposalRequestHandler)
+	// RegisterERC20ProposalHandler is the CLI and REST handler for the register ERC20 proposal
+	RegisterERC20ProposalHandler = govclient.NewProposalHandler(cli.NewRegisterERC20ProposalCmd, rest.RegisterERC20ProposalRequestHandler)
+	// ToggleTokenConversionProposalHandler is the CLI and REST handler for the toggle token conversion proposal
+	ToggleTokenConversionProposalHandler = govclient.NewProposalHandler(cli.NewToggleTokenConversionProposalCmd, rest.ToggleTokenConversionRESTHandler)
+)
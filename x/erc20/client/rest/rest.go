@@ -0,0 +1,137 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/tharsis/evmos/x/erc20/types"
+)
+
+// RegisterCoinProposalRequest defines a request for a new register coin proposal.
+type RegisterCoinProposalRequest struct {
+	BaseReq     rest.BaseReq       `json:"base_req" yaml:"base_req"`
+	Title       string             `json:"title" yaml:"title"`
+	Description string             `json:"description" yaml:"description"`
+	Deposit     sdk.Coins          `json:"deposit" yaml:"deposit"`
+	Metadata    banktypes.Metadata `json:"metadata" yaml:"metadata"`
+}
+
+// RegisterERC20ProposalRequest defines a request for a new register ERC20 proposal.
+type RegisterERC20ProposalRequest struct {
+	BaseReq      rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title        string       `json:"title" yaml:"title"`
+	Description  string       `json:"description" yaml:"description"`
+	Deposit      sdk.Coins    `json:"deposit" yaml:"deposit"`
+	ERC20Address string       `json:"erc20_address" yaml:"erc20_address"`
+}
+
+// ToggleTokenConversionProposalRequest defines a request for a toggle token conversion proposal.
+type ToggleTokenConversionProposalRequest struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Token       string       `json:"token" yaml:"token"`
+}
+
+// RegisterCoinProposalRequestHandler returns the REST handler for the register coin proposal
+func RegisterCoinProposalRequestHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: types.ModuleName,
+		Handler:  newRegisterCoinProposalHandler(clientCtx),
+	}
+}
+
+// RegisterERC20ProposalRequestHandler returns the REST handler for the register ERC20 proposal
+func RegisterERC20ProposalRequestHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: types.ModuleName,
+		Handler:  newRegisterERC20ProposalHandler(clientCtx),
+	}
+}
+
+// ToggleTokenConversionRESTHandler returns the REST handler for the toggle token conversion proposal
+func ToggleTokenConversionRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: types.ModuleName,
+		Handler:  newToggleTokenConversionHandler(clientCtx),
+	}
+}
+
+func newRegisterCoinProposalHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RegisterCoinProposalRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		content := types.NewRegisterCoinProposal(req.Title, req.Description, req.Metadata)
+		writeProposalTx(w, clientCtx, req.BaseReq, content, req.Deposit)
+	}
+}
+
+func newRegisterERC20ProposalHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RegisterERC20ProposalRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		if !common.IsHexAddress(req.ERC20Address) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid ERC20 contract address")
+			return
+		}
+
+		content := types.NewRegisterERC20Proposal(req.Title, req.Description, req.ERC20Address)
+		writeProposalTx(w, clientCtx, req.BaseReq, content, req.Deposit)
+	}
+}
+
+func newToggleTokenConversionHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ToggleTokenConversionProposalRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		content := types.NewToggleTokenConversionProposal(req.Title, req.Description, req.Token)
+		writeProposalTx(w, clientCtx, req.BaseReq, content, req.Deposit)
+	}
+}
+
+// writeProposalTx validates the base request and writes the generated
+// MsgSubmitProposal transaction to the response
+func writeProposalTx(w http.ResponseWriter, clientCtx client.Context, baseReq rest.BaseReq, content govtypes.Content, deposit sdk.Coins) {
+	baseReq = baseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return
+	}
+
+	fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, fromAddr)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+
+	if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+		return
+	}
+
+	tx.WriteGeneratedTxResponse(clientCtx, w, baseReq, msg)
+}
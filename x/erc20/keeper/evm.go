@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/tharsis/evmos/contracts"
+	"github.com/tharsis/evmos/x/erc20/types"
+)
+
+// DefaultGasCap defines the gas limit used for the EVM calls performed by
+// the erc20 module
+const DefaultGasCap uint64 = 25000000
+
+// DeployERC20Contract creates and deploys an ERC20 contract on the EVM with the
+// erc20 deployer address as owner.
+func (k Keeper) DeployERC20Contract(ctx sdk.Context, coinMetadata banktypes.Metadata) (common.Address, error) {
+	decimals := uint8(0)
+	if len(coinMetadata.DenomUnits) > 0 {
+		decimalsIdx := len(coinMetadata.DenomUnits) - 1
+		decimals = uint8(coinMetadata.DenomUnits[decimalsIdx].Exponent)
+	}
+
+	ctorArgs, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack(
+		"",
+		coinMetadata.Name,
+		coinMetadata.Symbol,
+		decimals,
+	)
+	if err != nil {
+		return common.Address{}, sdkerrors.Wrapf(types.ErrABIPack, "coin metadata is invalid %s: %s", coinMetadata.Name, err.Error())
+	}
+
+	data := make([]byte, len(contracts.ERC20MinterBurnerDecimalsContract.Bin)+len(ctorArgs))
+	copy(data[:len(contracts.ERC20MinterBurnerDecimalsContract.Bin)], contracts.ERC20MinterBurnerDecimalsContract.Bin)
+	copy(data[len(contracts.ERC20MinterBurnerDecimalsContract.Bin):], ctorArgs)
+
+	// the deployer account is created by the EVM on its first contract creation
+	nonce := uint64(0)
+	if acc := k.accountKeeper.GetAccount(ctx, types.DeployerAddress.Bytes()); acc != nil {
+		nonce = acc.GetSequence()
+	}
+
+	contractAddr := crypto.CreateAddress(types.DeployerAddress, nonce)
+	_, err = k.CallEVMWithData(ctx, types.DeployerAddress, nil, data)
+	if err != nil {
+		return common.Address{}, sdkerrors.Wrapf(err, "failed to deploy contract for %s", coinMetadata.Name)
+	}
+
+	return contractAddr, nil
+}
+
+// QueryERC20 returns the data of a deployed ERC20 contract
+func (k Keeper) QueryERC20(ctx sdk.Context, contract common.Address) (types.ERC20Data, error) {
+	var (
+		nameRes    types.ERC20StringResponse
+		symbolRes  types.ERC20StringResponse
+		decimalRes types.ERC20Uint8Response
+	)
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	// Name
+	res, err := k.CallEVM(ctx, erc20, types.ModuleAddress, contract, "name")
+	if err != nil {
+		return types.ERC20Data{}, err
+	}
+
+	if err := erc20.UnpackIntoInterface(&nameRes, "name", res.Ret); err != nil {
+		return types.ERC20Data{}, sdkerrors.Wrapf(
+			types.ErrABIUnpack, "failed to unpack name: %s", err.Error(),
+		)
+	}
+
+	// Symbol
+	res, err = k.CallEVM(ctx, erc20, types.ModuleAddress, contract, "symbol")
+	if err != nil {
+		return types.ERC20Data{}, err
+	}
+
+	if err := erc20.UnpackIntoInterface(&symbolRes, "symbol", res.Ret); err != nil {
+		return types.ERC20Data{}, sdkerrors.Wrapf(
+			types.ErrABIUnpack, "failed to unpack symbol: %s", err.Error(),
+		)
+	}
+
+	// Decimals
+	res, err = k.CallEVM(ctx, erc20, types.ModuleAddress, contract, "decimals")
+	if err != nil {
+		return types.ERC20Data{}, err
+	}
+
+	if err := erc20.UnpackIntoInterface(&decimalRes, "decimals", res.Ret); err != nil {
+		return types.ERC20Data{}, sdkerrors.Wrapf(
+			types.ErrABIUnpack, "failed to unpack decimals: %s", err.Error(),
+		)
+	}
+
+	return types.NewERC20Data(nameRes.Value, symbolRes.Value, decimalRes.Value), nil
+}
+
+// BalanceOf returns the ERC20 token balance of the given account. It returns
+// nil if the call fails or the response cannot be unpacked.
+func (k Keeper) BalanceOf(ctx sdk.Context, abi abi.ABI, contract, account common.Address) *big.Int {
+	res, err := k.CallEVM(ctx, abi, types.ModuleAddress, contract, "balanceOf", account)
+	if err != nil {
+		return nil
+	}
+
+	unpacked, err := abi.Unpack("balanceOf", res.Ret)
+	if err != nil || len(unpacked) == 0 {
+		return nil
+	}
+
+	balance, ok := unpacked[0].(*big.Int)
+	if !ok {
+		return nil
+	}
+
+	return balance
+}
+
+// CallEVM performs a smart contract method call using the given args
+func (k Keeper) CallEVM(
+	ctx sdk.Context,
+	abi abi.ABI,
+	from, contract common.Address,
+	method string,
+	args ...interface{},
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	data, err := abi.Pack(method, args...)
+	if err != nil {
+		return nil, sdkerrors.Wrap(
+			types.ErrABIPack,
+			sdkerrors.Wrap(err, "failed to create transaction data").Error(),
+		)
+	}
+
+	resp, err := k.CallEVMWithData(ctx, from, &contract, data)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "contract call failed: method '%s', contract '%s'", method, contract)
+	}
+	return resp, nil
+}
+
+// CallEVMWithData performs a smart contract method call using contract data.
+// A nil contract address performs a contract creation.
+func (k Keeper) CallEVMWithData(
+	ctx sdk.Context,
+	from common.Address,
+	contract *common.Address,
+	data []byte,
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	nonce := uint64(0)
+	if acc := k.accountKeeper.GetAccount(ctx, from.Bytes()); acc != nil {
+		nonce = acc.GetSequence()
+	}
+
+	msg := ethtypes.NewMessage(
+		from,
+		contract,
+		nonce,
+		big.NewInt(0), // amount
+		DefaultGasCap, // gasLimit
+		big.NewInt(0), // gasPrice
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		data,
+		ethtypes.AccessList{}, // accessList
+		false,                 // isFake
+	)
+
+	k.evmKeeper.WithContext(ctx)
+
+	res, err := k.evmKeeper.ApplyNativeMessage(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Failed() {
+		return nil, sdkerrors.Wrap(types.ErrEVMCall, res.VmError)
+	}
+
+	return res, nil
+}
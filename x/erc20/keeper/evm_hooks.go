@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tharsis/evmos/contracts"
+	"github.com/tharsis/evmos/x/erc20/types"
+)
+
+// PostTxProcessing implements the EVM post transaction hook. It converts the
+// ERC20 tokens of a registered token pair transferred to the module address
+// by an Ethereum transaction into Cosmos coins, which are sent to the token
+// sender:
+//   - native coin pair: the tokens are burned and the escrowed coins are sent
+//   - native ERC20 pair: the tokens are escrowed and the coins are minted
+//
+// An error reverts the whole transaction, so that the tokens aren't locked on
+// the module address when the conversion is disabled.
+func (k Keeper) PostTxProcessing(ctx sdk.Context, _ core.Message, receipt *ethtypes.Receipt) error {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	transferEvent := erc20.Events[types.ERC20EventTransfer]
+
+	for _, log := range receipt.Logs {
+		// Transfer(address indexed from, address indexed to, uint256 value)
+		if len(log.Topics) != 3 || log.Topics[0] != transferEvent.ID {
+			continue
+		}
+
+		to := common.BytesToAddress(log.Topics[2].Bytes())
+		if to != types.ModuleAddress {
+			continue
+		}
+
+		// ignore the tokens of the contracts without a token pair
+		contract := log.Address
+		if len(k.GetTokenPairID(ctx, contract.String())) == 0 {
+			continue
+		}
+
+		from := common.BytesToAddress(log.Topics[1].Bytes())
+		sender := sdk.AccAddress(from.Bytes())
+
+		pair, err := k.MintingEnabled(ctx, sender, sender, contract.String())
+		if err != nil {
+			return err
+		}
+
+		unpacked, err := transferEvent.Inputs.NonIndexed().Unpack(log.Data)
+		if err != nil || len(unpacked) != 1 {
+			return sdkerrors.Wrapf(types.ErrUnexpectedEvent, "failed to unpack transfer event of contract %s", contract)
+		}
+
+		tokens, ok := unpacked[0].(*big.Int)
+		if !ok || tokens.Sign() != 1 {
+			continue
+		}
+
+		if err := k.convertTransferredTokens(ctx, pair, sender, tokens); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeConvertERC20,
+				sdk.NewAttribute(sdk.AttributeKeySender, from.Hex()),
+				sdk.NewAttribute(types.AttributeKeyReceiver, sender.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, tokens.String()),
+				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+				sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			),
+		)
+	}
+
+	return nil
+}
+
+// convertTransferredTokens converts the tokens already transferred to the
+// module address into coins sent to the receiver.
+func (k Keeper) convertTransferredTokens(ctx sdk.Context, pair types.TokenPair, receiver sdk.AccAddress, tokens *big.Int) error {
+	coins := sdk.Coins{sdk.NewCoin(pair.Denom, sdk.NewIntFromBigInt(tokens))}
+
+	switch {
+	case pair.IsNativeCoin():
+		// burn the tokens received by the module address and unescrow the coins
+		erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+		if _, err := k.CallEVM(ctx, erc20, types.DeployerAddress, pair.GetERC20Contract(), "burnCoins", types.ModuleAddress, tokens); err != nil {
+			return err
+		}
+	case pair.IsNativeERC20():
+		// the tokens remain escrowed on the module address
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+	default:
+		return sdkerrors.Wrapf(types.ErrUndefinedOwner, "token pair for contract %s", pair.Erc20Address)
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins)
+}
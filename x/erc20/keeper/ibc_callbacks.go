@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+
+	"github.com/tharsis/evmos/ibc"
+	"github.com/tharsis/evmos/x/erc20/types"
+)
+
+// OnRecvPacket converts the ICS-20 vouchers received by an account into their
+// ERC20 token representation when the voucher denomination is part of an
+// enabled token pair. The conversion is performed on a cached context so that
+// the received coins are left untouched on the receiver balance if it fails.
+// The acknowledgement returned by the underlying application is never modified.
+func (k Keeper) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ack exported.Acknowledgement,
+) exported.Acknowledgement {
+	// the transfer failed, so there are no vouchers to convert
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	if !k.IsERC20Enabled(ctx) {
+		return ack
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return ack
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return ack
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return ack
+	}
+
+	coin := sdk.NewCoin(ibc.GetReceivedDenom(packet, data), amount)
+
+	pairID := k.GetTokenPairID(ctx, coin.Denom)
+	if len(pairID) == 0 {
+		// no token pair registered for the voucher
+		return ack
+	}
+
+	pair, found := k.GetTokenPair(ctx, pairID)
+	if !found || !pair.Enabled {
+		return ack
+	}
+
+	// use a new event manager so that the events of a failed conversion are
+	// discarded together with its state changes
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+	msg := types.NewMsgConvertCoin(coin, common.BytesToAddress(receiver), receiver)
+	if _, err := k.ConvertCoin(sdk.WrapSDKContext(cacheCtx), msg); err != nil {
+		k.Logger(ctx).Error(
+			"failed to convert received IBC vouchers to ERC20",
+			"receiver", data.Receiver,
+			"coin", coin.String(),
+			"error", err.Error(),
+		)
+		return ack
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return ack
+}
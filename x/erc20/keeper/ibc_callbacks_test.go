@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+func (suite *KeeperTestSuite) TestOnRecvPacket() {
+	receiver := sdk.AccAddress(suite.address.Bytes())
+	ibcDenom := transfertypes.ParseDenomTrace("transfer/channel-1/uatom").IBCDenom()
+
+	// the vouchers are minted by the transfer application before the middleware
+	// is executed
+	suite.mintCoins(receiver, sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 100)))
+
+	pair, err := suite.app.Erc20Keeper.RegisterCoin(suite.ctx, banktypes.Metadata{
+		Description: "IBC voucher of uatom",
+		Base:        ibcDenom,
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: ibcDenom, Exponent: 0},
+			{Denom: "atom", Exponent: 6},
+		},
+		Name:    "atom",
+		Symbol:  "ATOM",
+		Display: "atom",
+	})
+	suite.Require().NoError(err)
+
+	newPacket := func(denom string) channeltypes.Packet {
+		data := transfertypes.NewFungibleTokenPacketData(denom, "40", "cosmos1sender", receiver.String())
+		return channeltypes.NewPacket(
+			data.GetBytes(), 1, "transfer", "channel-0", "transfer", "channel-1", clienttypes.NewHeight(0, 100), 0,
+		)
+	}
+
+	successAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+
+	testCases := []struct {
+		name       string
+		packet     channeltypes.Packet
+		ack        exported.Acknowledgement
+		expBalance int64
+		expTokens  int64
+	}{
+		{"error acknowledgement", newPacket("uatom"), channeltypes.NewErrorAcknowledgement("failed"), 100, 0},
+		{"no token pair", newPacket("uosmo"), successAck, 100, 0},
+		{"vouchers converted", newPacket("uatom"), successAck, 60, 40},
+	}
+
+	for _, tc := range testCases {
+		ack := suite.app.Erc20Keeper.OnRecvPacket(suite.ctx, tc.packet, tc.ack)
+		suite.Require().Equal(tc.ack, ack, tc.name)
+
+		balance := suite.app.BankKeeper.GetBalance(suite.ctx, receiver, ibcDenom)
+		suite.Require().Equal(tc.expBalance, balance.Amount.Int64(), tc.name)
+		suite.Require().Equal(tc.expTokens, suite.balanceOf(pair.GetERC20Contract(), suite.address).Int64(), tc.name)
+	}
+
+	// conversion fails when the token pair is disabled, leaving the vouchers
+	// on the receiver balance
+	_, err = suite.app.Erc20Keeper.ToggleConversion(suite.ctx, ibcDenom)
+	suite.Require().NoError(err)
+
+	suite.app.Erc20Keeper.OnRecvPacket(suite.ctx, newPacket("uatom"), successAck)
+	suite.Require().Equal(int64(60), suite.app.BankKeeper.GetBalance(suite.ctx, receiver, ibcDenom).Amount.Int64())
+}
@@ -0,0 +1,215 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+
+	"github.com/tharsis/evmos/app"
+	"github.com/tharsis/evmos/contracts"
+	"github.com/tharsis/evmos/x/erc20/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx     sdk.Context
+	app     *app.Evmos
+	address common.Address
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	priv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	suite.address = common.BytesToAddress(priv.PubKey().Address().Bytes())
+
+	// consensus key
+	consPriv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	consAddress := sdk.ConsAddress(consPriv.PubKey().Address())
+
+	suite.app = app.Setup(false, nil)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Height:          1,
+		ChainID:         "evmos_9000-1",
+		Time:            time.Now().UTC(),
+		ProposerAddress: consAddress.Bytes(),
+	})
+	suite.app.EvmKeeper.WithChainID(suite.ctx)
+
+	// the EVM requires the block proposer to be a validator
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(suite.address.Bytes()), consPriv.PubKey(), stakingtypes.Description{})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.app.StakingKeeper.SetValidatorByConsAddr(suite.ctx, validator))
+	suite.app.StakingKeeper.SetValidator(suite.ctx, validator)
+
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, suite.address.Bytes())
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+}
+
+func (suite *KeeperTestSuite) mintCoins(addr sdk.AccAddress, coins sdk.Coins) {
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, addr, coins))
+}
+
+func (suite *KeeperTestSuite) balanceOf(contract, account common.Address) *big.Int {
+	return suite.app.Erc20Keeper.BalanceOf(suite.ctx, contracts.ERC20MinterBurnerDecimalsContract.ABI, contract, account)
+}
+
+func (suite *KeeperTestSuite) TestNativeCoinConversion() {
+	denom := "acoin"
+	sender := sdk.AccAddress(suite.address.Bytes())
+	suite.mintCoins(sender, sdk.NewCoins(sdk.NewInt64Coin(denom, 100)))
+
+	metadata := banktypes.Metadata{
+		Description: "description of the test coin",
+		Base:        denom,
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "coin", Exponent: 18},
+		},
+		Name:    "coin",
+		Symbol:  "COIN",
+		Display: "coin",
+	}
+
+	pair, err := suite.app.Erc20Keeper.RegisterCoin(suite.ctx, metadata)
+	suite.Require().NoError(err)
+	suite.Require().True(pair.IsNativeCoin())
+
+	_, err = suite.app.Erc20Keeper.RegisterCoin(suite.ctx, metadata)
+	suite.Require().Error(err, "coin registered twice")
+
+	erc20Data, err := suite.app.Erc20Keeper.QueryERC20(suite.ctx, pair.GetERC20Contract())
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewERC20Data("coin", "COIN", 18), erc20Data)
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	msgConvertCoin := types.NewMsgConvertCoin(sdk.NewInt64Coin(denom, 60), suite.address, sender)
+	_, err = suite.app.Erc20Keeper.ConvertCoin(ctx, msgConvertCoin)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(40), suite.app.BankKeeper.GetBalance(suite.ctx, sender, denom).Amount.Int64())
+	suite.Require().Equal(int64(60), suite.balanceOf(pair.GetERC20Contract(), suite.address).Int64())
+
+	msgConvertERC20 := types.NewMsgConvertERC20(sdk.NewInt(10), sender, pair.GetERC20Contract(), suite.address)
+	_, err = suite.app.Erc20Keeper.ConvertERC20(ctx, msgConvertERC20)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(50), suite.app.BankKeeper.GetBalance(suite.ctx, sender, denom).Amount.Int64())
+	suite.Require().Equal(int64(50), suite.balanceOf(pair.GetERC20Contract(), suite.address).Int64())
+
+	_, err = suite.app.Erc20Keeper.ToggleConversion(suite.ctx, denom)
+	suite.Require().NoError(err)
+	_, err = suite.app.Erc20Keeper.ConvertCoin(ctx, msgConvertCoin)
+	suite.Require().ErrorIs(err, types.ErrERC20Disabled)
+}
+
+func (suite *KeeperTestSuite) TestNativeERC20Conversion() {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	// deploy a contract owned by the test account and mint some tokens
+	ctorArgs, err := erc20.Pack("", "Test Token", "TEST", uint8(6))
+	suite.Require().NoError(err)
+	data := append(contracts.ERC20MinterBurnerDecimalsContract.Bin, ctorArgs...) // nolint: gocritic
+	nonce := suite.app.EvmKeeper.GetNonce(suite.address)
+	_, err = suite.app.Erc20Keeper.CallEVMWithData(suite.ctx, suite.address, nil, data)
+	suite.Require().NoError(err)
+	contract := crypto.CreateAddress(suite.address, nonce)
+
+	_, err = suite.app.Erc20Keeper.CallEVM(suite.ctx, erc20, suite.address, contract, "mint", suite.address, big.NewInt(100))
+	suite.Require().NoError(err)
+
+	pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contract)
+	suite.Require().NoError(err)
+	suite.Require().True(pair.IsNativeERC20())
+	suite.Require().Equal(types.CreateDenom(contract.String()), pair.Denom)
+
+	metadata, found := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, pair.Denom)
+	suite.Require().True(found)
+	suite.Require().Equal("testtoken", metadata.Display)
+
+	receiver := sdk.AccAddress(suite.address.Bytes())
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	_, err = suite.app.Erc20Keeper.ConvertERC20(ctx, types.NewMsgConvertERC20(sdk.NewInt(70), receiver, contract, suite.address))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(70), suite.app.BankKeeper.GetBalance(suite.ctx, receiver, pair.Denom).Amount.Int64())
+	suite.Require().Equal(int64(30), suite.balanceOf(contract, suite.address).Int64())
+	suite.Require().Equal(int64(70), suite.balanceOf(contract, types.ModuleAddress).Int64())
+
+	_, err = suite.app.Erc20Keeper.ConvertCoin(ctx, types.NewMsgConvertCoin(sdk.NewInt64Coin(pair.Denom, 20), suite.address, receiver))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(50), suite.app.BankKeeper.GetBalance(suite.ctx, receiver, pair.Denom).Amount.Int64())
+	suite.Require().Equal(int64(50), suite.balanceOf(contract, suite.address).Int64())
+	suite.Require().Equal(int64(50), suite.app.BankKeeper.GetSupply(suite.ctx, pair.Denom).Amount.Int64())
+}
+
+func (suite *KeeperTestSuite) TestPostTxProcessing() {
+	denom := "acoin"
+	sender := sdk.AccAddress(suite.address.Bytes())
+	suite.mintCoins(sender, sdk.NewCoins(sdk.NewInt64Coin(denom, 100)))
+
+	metadata := banktypes.Metadata{
+		Base:       denom,
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}, {Denom: "coin", Exponent: 18}},
+		Name:       "coin",
+		Symbol:     "COIN",
+		Display:    "coin",
+	}
+
+	pair, err := suite.app.Erc20Keeper.RegisterCoin(suite.ctx, metadata)
+	suite.Require().NoError(err)
+	contract := pair.GetERC20Contract()
+
+	_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), types.NewMsgConvertCoin(sdk.NewInt64Coin(denom, 60), suite.address, sender))
+	suite.Require().NoError(err)
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	transfer := func(to common.Address, amount int64) *ethtypes.Receipt {
+		_, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, erc20, suite.address, contract, "transfer", to, big.NewInt(amount))
+		suite.Require().NoError(err)
+
+		// the logs are only returned for Ethereum transactions
+		data, err := erc20.Events[types.ERC20EventTransfer].Inputs.NonIndexed().Pack(big.NewInt(amount))
+		suite.Require().NoError(err)
+		log := &ethtypes.Log{
+			Address: contract,
+			Topics:  []common.Hash{erc20.Events[types.ERC20EventTransfer].ID, suite.address.Hash(), to.Hash()},
+			Data:    data,
+		}
+		return &ethtypes.Receipt{Logs: []*ethtypes.Log{log}}
+	}
+
+	// tokens transferred to another address are ignored
+	err = suite.app.Erc20Keeper.PostTxProcessing(suite.ctx, nil, transfer(common.Address{1}, 10))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(40), suite.app.BankKeeper.GetBalance(suite.ctx, sender, denom).Amount.Int64())
+
+	// tokens transferred to the module address are converted to coins
+	err = suite.app.Erc20Keeper.PostTxProcessing(suite.ctx, nil, transfer(types.ModuleAddress, 20))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(60), suite.app.BankKeeper.GetBalance(suite.ctx, sender, denom).Amount.Int64())
+	suite.Require().Equal(int64(30), suite.balanceOf(contract, suite.address).Int64())
+	suite.Require().Equal(int64(0), suite.balanceOf(contract, types.ModuleAddress).Int64())
+
+	// the transfer is reverted when the conversion is disabled
+	_, err = suite.app.Erc20Keeper.ToggleConversion(suite.ctx, denom)
+	suite.Require().NoError(err)
+	err = suite.app.Erc20Keeper.PostTxProcessing(suite.ctx, nil, transfer(types.ModuleAddress, 20))
+	suite.Require().ErrorIs(err, types.ErrERC20Disabled)
+}
@@ -0,0 +1,383 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tharsis/evmos/contracts"
+	"github.com/tharsis/evmos/x/erc20/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// ConvertCoin converts Cosmos-native Coins into ERC20 tokens for both
+// Cosmos-native and ERC20 TokenPair Owners
+func (k Keeper) ConvertCoin(goCtx context.Context, msg *types.MsgConvertCoin) (*types.MsgConvertCoinResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	receiver := common.HexToAddress(msg.Receiver)
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+
+	pair, err := k.MintingEnabled(ctx, sender, receiver.Bytes(), msg.Coin.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check ownership and execute conversion
+	switch {
+	case pair.IsNativeCoin():
+		return k.convertCoinNativeCoin(ctx, pair, msg, receiver, sender) // case 1.1
+	case pair.IsNativeERC20():
+		return k.convertCoinNativeERC20(ctx, pair, msg, receiver, sender) // case 2.2
+	default:
+		return nil, sdkerrors.Wrapf(types.ErrUndefinedOwner, "token pair for denom %s", msg.Coin.Denom)
+	}
+}
+
+// ConvertERC20 converts ERC20 tokens into Cosmos-native Coins for both
+// Cosmos-native and ERC20 TokenPair Owners
+func (k Keeper) ConvertERC20(goCtx context.Context, msg *types.MsgConvertERC20) (*types.MsgConvertERC20Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	receiver, _ := sdk.AccAddressFromBech32(msg.Receiver)
+	sender := common.HexToAddress(msg.Sender)
+
+	pair, err := k.MintingEnabled(ctx, sender.Bytes(), receiver, msg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check ownership and execute conversion
+	switch {
+	case pair.IsNativeCoin():
+		return k.convertERC20NativeCoin(ctx, pair, msg, receiver, sender) // case 1.2
+	case pair.IsNativeERC20():
+		return k.convertERC20NativeToken(ctx, pair, msg, receiver, sender) // case 2.1
+	default:
+		return nil, sdkerrors.Wrapf(types.ErrUndefinedOwner, "token pair for contract %s", msg.ContractAddress)
+	}
+}
+
+// MintingEnabled checks that:
+//   - the global parameter for erc20 conversion is enabled
+//   - the token pair is registered and enabled
+//   - the coin can be sent to the receiver
+func (k Keeper) MintingEnabled(ctx sdk.Context, sender, receiver sdk.AccAddress, token string) (types.TokenPair, error) {
+	if !k.IsERC20Enabled(ctx) {
+		return types.TokenPair{}, sdkerrors.Wrap(types.ErrERC20Disabled, "module is currently disabled by governance")
+	}
+
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrTokenPairNotFound, "token '%s' not registered by id", token)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrTokenPairNotFound, "token '%s' not registered", token)
+	}
+
+	if !pair.Enabled {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrERC20Disabled, "minting token '%s' is not enabled by governance", token)
+	}
+
+	if k.bankKeeper.BlockedAddr(receiver.Bytes()) {
+		return types.TokenPair{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive transactions", receiver)
+	}
+
+	// NOTE: ignore amount as only denom is checked on IsSendEnabledCoin
+	coin := sdk.Coin{Denom: pair.Denom}
+
+	// check if minting to a recipient address other than the sender is enabled
+	// for the given coin denom
+	if !sender.Equals(receiver) && !k.bankKeeper.IsSendEnabledCoin(ctx, coin) {
+		return types.TokenPair{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "minting '%s' coins to an external address is currently disabled", token)
+	}
+
+	return pair, nil
+}
+
+// convertCoinNativeCoin handles the Coin conversion flow for a native coin
+// token pair:
+//   - Escrow Coins on module account (Coins are not burned)
+//   - Mint Tokens and send to receiver
+//   - Check if token balance increased by amount
+func (k Keeper) convertCoinNativeCoin(ctx sdk.Context, pair types.TokenPair, msg *types.MsgConvertCoin, receiver common.Address, sender sdk.AccAddress) (*types.MsgConvertCoinResponse, error) {
+	// NOTE: ignore validation from NewCoin constructor
+	coins := sdk.Coins{msg.Coin}
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	contract := pair.GetERC20Contract()
+	balanceToken := k.BalanceOf(ctx, erc20, contract, receiver)
+	if balanceToken == nil {
+		return nil, sdkerrors.Wrap(types.ErrEVMCall, "failed to retrieve balance")
+	}
+
+	// Escrow coins on module account
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to escrow coins")
+	}
+
+	// Mint tokens and send to receiver
+	_, err := k.CallEVM(ctx, erc20, types.DeployerAddress, contract, "mint", receiver, msg.Coin.Amount.BigInt())
+	if err != nil {
+		return nil, err
+	}
+
+	// Check expected receiver balance after transfer
+	tokens := msg.Coin.Amount.BigInt()
+	balanceTokenAfter := k.BalanceOf(ctx, erc20, contract, receiver)
+	if balanceTokenAfter == nil {
+		return nil, sdkerrors.Wrap(types.ErrEVMCall, "failed to retrieve balance")
+	}
+	expToken := big.NewInt(0).Add(balanceToken, tokens)
+
+	if r := balanceTokenAfter.Cmp(expToken); r != 0 {
+		return nil, sdkerrors.Wrapf(
+			types.ErrBalanceInvariance,
+			"invalid token balance - expected: %v, actual: %v", expToken, balanceTokenAfter,
+		)
+	}
+
+	emitConvertCoinEvent(ctx, msg, pair)
+
+	return &types.MsgConvertCoinResponse{}, nil
+}
+
+// convertERC20NativeCoin handles the ERC20 conversion flow for a native coin
+// token pair:
+//   - Burn escrowed tokens
+//   - Unescrow coins that have been previously escrowed with ConvertCoin
+//   - Check if coin balance increased by amount
+//   - Check if token balance decreased by amount
+func (k Keeper) convertERC20NativeCoin(ctx sdk.Context, pair types.TokenPair, msg *types.MsgConvertERC20, receiver sdk.AccAddress, sender common.Address) (*types.MsgConvertERC20Response, error) {
+	// NOTE: coin fields already validated
+	coins := sdk.Coins{sdk.Coin{Denom: pair.Denom, Amount: msg.Amount}}
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	contract := pair.GetERC20Contract()
+	balanceCoin := k.bankKeeper.GetBalance(ctx, receiver, pair.Denom)
+	balanceToken := k.BalanceOf(ctx, erc20, contract, sender)
+	if balanceToken == nil {
+		return nil, sdkerrors.Wrap(types.ErrEVMCall, "failed to retrieve balance")
+	}
+
+	// Burn escrowed tokens
+	_, err := k.CallEVM(ctx, erc20, types.DeployerAddress, contract, "burnCoins", sender, msg.Amount.BigInt())
+	if err != nil {
+		return nil, err
+	}
+
+	// Unescrow coins and send to receiver
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins); err != nil {
+		return nil, err
+	}
+
+	// Check expected receiver balance after transfer
+	balanceCoinAfter := k.bankKeeper.GetBalance(ctx, receiver, pair.Denom)
+	expCoin := balanceCoin.Add(coins[0])
+	if ok := balanceCoinAfter.IsEqual(expCoin); !ok {
+		return nil, sdkerrors.Wrapf(
+			types.ErrBalanceInvariance,
+			"invalid coin balance - expected: %v, actual: %v",
+			expCoin, balanceCoinAfter,
+		)
+	}
+
+	// Check expected sender balance after transfer
+	tokens := coins[0].Amount.BigInt()
+	balanceTokenAfter := k.BalanceOf(ctx, erc20, contract, sender)
+	if balanceTokenAfter == nil {
+		return nil, sdkerrors.Wrap(types.ErrEVMCall, "failed to retrieve balance")
+	}
+	expToken := big.NewInt(0).Sub(balanceToken, tokens)
+
+	if r := balanceTokenAfter.Cmp(expToken); r != 0 {
+		return nil, sdkerrors.Wrapf(
+			types.ErrBalanceInvariance,
+			"invalid token balance - expected: %v, actual: %v",
+			expToken, balanceTokenAfter,
+		)
+	}
+
+	emitConvertERC20Event(ctx, msg, pair)
+
+	return &types.MsgConvertERC20Response{}, nil
+}
+
+// convertERC20NativeToken handles the ERC20 conversion flow for a native ERC20
+// token pair:
+//   - Escrow tokens on module account
+//   - Mint coins and send to receiver
+//   - Check if coin balance increased by amount
+//   - Check if token balance decreased by amount
+func (k Keeper) convertERC20NativeToken(ctx sdk.Context, pair types.TokenPair, msg *types.MsgConvertERC20, receiver sdk.AccAddress, sender common.Address) (*types.MsgConvertERC20Response, error) {
+	// NOTE: coin fields already validated
+	coins := sdk.Coins{sdk.Coin{Denom: pair.Denom, Amount: msg.Amount}}
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	contract := pair.GetERC20Contract()
+	balanceCoin := k.bankKeeper.GetBalance(ctx, receiver, pair.Denom)
+	balanceToken := k.BalanceOf(ctx, erc20, contract, types.ModuleAddress)
+	if balanceToken == nil {
+		return nil, sdkerrors.Wrap(types.ErrEVMCall, "failed to retrieve balance")
+	}
+
+	// Escrow tokens on module account
+	res, err := k.CallEVM(ctx, erc20, sender, contract, "transfer", types.ModuleAddress, msg.Amount.BigInt())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := unpackTransferSuccess(res.Ret); err != nil {
+		return nil, err
+	}
+
+	// Mint coins
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	// Send minted coins to the receiver
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins); err != nil {
+		return nil, err
+	}
+
+	// Check expected receiver balance after transfer
+	balanceCoinAfter := k.bankKeeper.GetBalance(ctx, receiver, pair.Denom)
+	expCoin := balanceCoin.Add(coins[0])
+
+	if ok := balanceCoinAfter.IsEqual(expCoin); !ok {
+		return nil, sdkerrors.Wrapf(
+			types.ErrBalanceInvariance,
+			"invalid coin balance - expected: %v, actual: %v",
+			expCoin, balanceCoinAfter,
+		)
+	}
+
+	// Check expected escrow balance after transfer execution
+	tokens := coins[0].Amount.BigInt()
+	balanceTokenAfter := k.BalanceOf(ctx, erc20, contract, types.ModuleAddress)
+	if balanceTokenAfter == nil {
+		return nil, sdkerrors.Wrap(types.ErrEVMCall, "failed to retrieve balance")
+	}
+	expToken := big.NewInt(0).Add(balanceToken, tokens)
+
+	if r := balanceTokenAfter.Cmp(expToken); r != 0 {
+		return nil, sdkerrors.Wrapf(
+			types.ErrBalanceInvariance,
+			"invalid token balance - expected: %v, actual: %v",
+			expToken, balanceTokenAfter,
+		)
+	}
+
+	emitConvertERC20Event(ctx, msg, pair)
+
+	return &types.MsgConvertERC20Response{}, nil
+}
+
+// convertCoinNativeERC20 handles the Coin conversion flow for a native ERC20
+// token pair:
+//   - Escrow Coins on module account
+//   - Unescrow Tokens that have been previously escrowed with ConvertERC20 and send to receiver
+//   - Burn escrowed Coins
+//   - Check if token balance increased by amount
+func (k Keeper) convertCoinNativeERC20(ctx sdk.Context, pair types.TokenPair, msg *types.MsgConvertCoin, receiver common.Address, sender sdk.AccAddress) (*types.MsgConvertCoinResponse, error) {
+	// NOTE: ignore validation from NewCoin constructor
+	coins := sdk.Coins{msg.Coin}
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	contract := pair.GetERC20Contract()
+	balanceToken := k.BalanceOf(ctx, erc20, contract, receiver)
+	if balanceToken == nil {
+		return nil, sdkerrors.Wrap(types.ErrEVMCall, "failed to retrieve balance")
+	}
+
+	// Escrow Coins on module account
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to escrow coins")
+	}
+
+	// Unescrow Tokens and send to receiver
+	res, err := k.CallEVM(ctx, erc20, types.ModuleAddress, contract, "transfer", receiver, msg.Coin.Amount.BigInt())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := unpackTransferSuccess(res.Ret); err != nil {
+		return nil, err
+	}
+
+	// Check expected receiver balance after transfer
+	balanceTokenAfter := k.BalanceOf(ctx, erc20, contract, receiver)
+	if balanceTokenAfter == nil {
+		return nil, sdkerrors.Wrap(types.ErrEVMCall, "failed to retrieve balance")
+	}
+	expToken := big.NewInt(0).Add(balanceToken, msg.Coin.Amount.BigInt())
+
+	if r := balanceTokenAfter.Cmp(expToken); r != 0 {
+		return nil, sdkerrors.Wrapf(
+			types.ErrBalanceInvariance,
+			"invalid token balance - expected: %v, actual: %v", expToken, balanceTokenAfter,
+		)
+	}
+
+	// Burn escrowed Coins
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to burn coins")
+	}
+
+	emitConvertCoinEvent(ctx, msg, pair)
+
+	return &types.MsgConvertCoinResponse{}, nil
+}
+
+// unpackTransferSuccess checks that the boolean returned by an ERC20 transfer
+// call is true
+func unpackTransferSuccess(ret []byte) error {
+	var unpackedRet struct{ Value bool }
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	if err := erc20.UnpackIntoInterface(&unpackedRet, "transfer", ret); err != nil {
+		return sdkerrors.Wrapf(types.ErrABIUnpack, "failed to unpack transfer: %s", err.Error())
+	}
+
+	if !unpackedRet.Value {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "failed to execute transfer")
+	}
+
+	return nil
+}
+
+func emitConvertCoinEvent(ctx sdk.Context, msg *types.MsgConvertCoin, pair types.TokenPair) {
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeConvertCoin,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+				sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Coin.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyCosmosCoin, msg.Coin.Denom),
+				sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			),
+		},
+	)
+}
+
+func emitConvertERC20Event(ctx sdk.Context, msg *types.MsgConvertERC20, pair types.TokenPair) {
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeConvertERC20,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+				sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+				sdk.NewAttribute(types.AttributeKeyERC20Token, msg.ContractAddress),
+			),
+		},
+	)
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/x/erc20/types"
+)
+
+// GetParams returns the total set of erc20 parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramstore.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the erc20 parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramstore.SetParamSet(ctx, &params)
+}
+
+// IsERC20Enabled returns true if the module logic is enabled
+func (k Keeper) IsERC20Enabled(ctx sdk.Context) bool {
+	return k.GetParams(ctx).EnableErc20
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/x/erc20/types"
+)
+
+// GetAllTokenPairs returns all the registered token pairs
+func (k Keeper) GetAllTokenPairs(ctx sdk.Context) []types.TokenPair {
+	tokenPairs := []types.TokenPair{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var tokenPair types.TokenPair
+		k.cdc.MustUnmarshal(iterator.Value(), &tokenPair)
+
+		tokenPairs = append(tokenPairs, tokenPair)
+	}
+
+	return tokenPairs
+}
+
+// GetTokenPairID returns the pair id from either of the registered tokens.
+// The token can be either a hex contract address or a cosmos coin denomination.
+func (k Keeper) GetTokenPairID(ctx sdk.Context, token string) []byte {
+	if common.IsHexAddress(token) {
+		addr := common.HexToAddress(token)
+		return k.GetERC20Map(ctx, addr)
+	}
+	return k.GetDenomMap(ctx, token)
+}
+
+// GetTokenPair returns a registered token pair for the given id
+func (k Keeper) GetTokenPair(ctx sdk.Context, id []byte) (types.TokenPair, bool) {
+	if id == nil {
+		return types.TokenPair{}, false
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+	var tokenPair types.TokenPair
+	bz := store.Get(id)
+	if len(bz) == 0 {
+		return types.TokenPair{}, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &tokenPair)
+	return tokenPair, true
+}
+
+// SetTokenPair stores a token pair
+func (k Keeper) SetTokenPair(ctx sdk.Context, tokenPair types.TokenPair) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+	key := tokenPair.GetID()
+	bz := k.cdc.MustMarshal(&tokenPair)
+	store.Set(key, bz)
+}
+
+// GetERC20Map returns the token pair id for the given ERC20 contract address
+func (k Keeper) GetERC20Map(ctx sdk.Context, erc20 common.Address) []byte {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByERC20)
+	return store.Get(erc20.Bytes())
+}
+
+// GetDenomMap returns the token pair id for the given denomination
+func (k Keeper) GetDenomMap(ctx sdk.Context, denom string) []byte {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByDenom)
+	return store.Get([]byte(denom))
+}
+
+// SetERC20Map sets the token pair id for the given ERC20 contract address
+func (k Keeper) SetERC20Map(ctx sdk.Context, erc20 common.Address, id []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByERC20)
+	store.Set(erc20.Bytes(), id)
+}
+
+// SetDenomMap sets the token pair id for the given denomination
+func (k Keeper) SetDenomMap(ctx sdk.Context, denom string, id []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByDenom)
+	store.Set([]byte(denom), id)
+}
+
+// IsTokenPairRegistered checks if a token pair with the given id exists
+func (k Keeper) IsTokenPairRegistered(ctx sdk.Context, id []byte) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+	return store.Has(id)
+}
+
+// IsERC20Registered checks if an ERC20 contract is part of a registered token pair
+func (k Keeper) IsERC20Registered(ctx sdk.Context, erc20 common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByERC20)
+	return store.Has(erc20.Bytes())
+}
+
+// IsDenomRegistered checks if a denomination is part of a registered token pair
+func (k Keeper) IsDenomRegistered(ctx sdk.Context, denom string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByDenom)
+	return store.Has([]byte(denom))
+}
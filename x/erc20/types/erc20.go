@@ -0,0 +1,48 @@
+package types
+
+import (
+	"regexp"
+	"strings"
+)
+
+// ERC20EventTransfer is the name of the ERC20 Transfer event
+const ERC20EventTransfer = "Transfer"
+
+// denomInvalidChars matches the characters that are not allowed on a cosmos
+// coin denomination
+var denomInvalidChars = regexp.MustCompile(`[^a-z0-9/:._-]`)
+
+// ERC20Data represents the ERC20 token details used to map
+// the token to a Cosmos Coin
+type ERC20Data struct {
+	Name     string
+	Symbol   string
+	Decimals uint8
+}
+
+// ERC20StringResponse defines the string value from the call response
+type ERC20StringResponse struct {
+	Value string
+}
+
+// ERC20Uint8Response defines the uint8 value from the call response
+type ERC20Uint8Response struct {
+	Value uint8
+}
+
+// NewERC20Data creates a new ERC20Data instance
+func NewERC20Data(name, symbol string, decimals uint8) ERC20Data {
+	return ERC20Data{
+		Name:     name,
+		Symbol:   symbol,
+		Decimals: decimals,
+	}
+}
+
+// SanitizeERC20Name converts an ERC20 token name into a lowercase string that
+// only contains characters allowed on a cosmos coin denomination.
+func SanitizeERC20Name(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = strings.ReplaceAll(name, " ", "")
+	return denomInvalidChars.ReplaceAllString(name, "")
+}
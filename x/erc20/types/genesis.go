@@ -0,0 +1,45 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, pairs []TokenPair) GenesisState {
+	return GenesisState{
+		Params:     params,
+		TokenPairs: pairs,
+	}
+}
+
+// DefaultGenesisState returns the default erc20 module genesis state with no
+// registered token pairs.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenErc20 := make(map[string]bool)
+	seenDenom := make(map[string]bool)
+
+	for _, b := range gs.TokenPairs {
+		if err := b.Validate(); err != nil {
+			return err
+		}
+
+		if seenErc20[b.Erc20Address] {
+			return fmt.Errorf("token ERC20 contract duplicated on genesis '%s'", b.Erc20Address)
+		}
+
+		if seenDenom[b.Denom] {
+			return fmt.Errorf("coin denomination duplicated on genesis: '%s'", b.Denom)
+		}
+
+		seenErc20[b.Erc20Address] = true
+		seenDenom[b.Denom] = true
+	}
+
+	return gs.Params.Validate()
+}
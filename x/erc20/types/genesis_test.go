@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	erc20 := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	pair := NewTokenPair(erc20, "acoin", true, OWNER_MODULE)
+
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expPass  bool
+	}{
+		{"default", DefaultGenesisState(), true},
+		{"valid token pair", &GenesisState{Params: DefaultParams(), TokenPairs: []TokenPair{pair}}, true},
+		{
+			"duplicated ERC20 contract",
+			&GenesisState{
+				Params:     DefaultParams(),
+				TokenPairs: []TokenPair{pair, NewTokenPair(erc20, "bcoin", true, OWNER_MODULE)},
+			},
+			false,
+		},
+		{
+			"duplicated denomination",
+			&GenesisState{
+				Params:     DefaultParams(),
+				TokenPairs: []TokenPair{pair, NewTokenPair(common.HexToAddress("0x01"), "acoin", true, OWNER_MODULE)},
+			},
+			false,
+		},
+		{
+			"undefined owner",
+			&GenesisState{
+				Params:     DefaultParams(),
+				TokenPairs: []TokenPair{NewTokenPair(erc20, "acoin", true, OWNER_UNSPECIFIED)},
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
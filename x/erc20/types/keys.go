@@ -0,0 +1,49 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// constants
+const (
+	// module name
+	ModuleName = "erc20"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+var (
+	// ModuleAddress is the native module address for ERC20
+	ModuleAddress common.Address
+
+	// DeployerAddress is the address used by the erc20 module to deploy, and
+	// own, the ERC20 contracts of native coins. A separate address is required
+	// as the sequence of a module account cannot be increased by the EVM on
+	// contract creation.
+	DeployerAddress common.Address
+)
+
+func init() {
+	ModuleAddress = common.BytesToAddress(authtypes.NewModuleAddress(ModuleName).Bytes())
+	DeployerAddress = common.BytesToAddress(authtypes.NewModuleAddress(ModuleName + "/deployer").Bytes())
+}
+
+// prefix bytes for the ERC20 persistent store
+const (
+	prefixTokenPair = iota + 1
+	prefixTokenPairByERC20
+	prefixTokenPairByDenom
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixTokenPair        = []byte{prefixTokenPair}
+	KeyPrefixTokenPairByERC20 = []byte{prefixTokenPairByERC20}
+	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
+)
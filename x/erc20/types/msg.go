@@ -0,0 +1,114 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ethermint "github.com/tharsis/ethermint/types"
+)
+
+var (
+	_ sdk.Msg = &MsgConvertCoin{}
+	_ sdk.Msg = &MsgConvertERC20{}
+)
+
+const (
+	TypeMsgConvertCoin  = "convert_coin"
+	TypeMsgConvertERC20 = "convert_ERC20"
+)
+
+// NewMsgConvertCoin creates a new instance of MsgConvertCoin
+func NewMsgConvertCoin(coin sdk.Coin, receiver common.Address, sender sdk.AccAddress) *MsgConvertCoin { // nolint: interfacer
+	return &MsgConvertCoin{
+		Coin:     coin,
+		Receiver: receiver.Hex(),
+		Sender:   sender.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgConvertCoin) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgConvertCoin) Type() string { return TypeMsgConvertCoin }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConvertCoin) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Coin.Denom); err != nil {
+		return err
+	}
+
+	if !msg.Coin.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "cannot mint a non-positive amount")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+	if !common.IsHexAddress(msg.Receiver) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver hex address %s", msg.Receiver)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgConvertCoin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgConvertCoin) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil
+	}
+
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgConvertERC20 creates a new instance of MsgConvertERC20
+func NewMsgConvertERC20(amount sdk.Int, receiver sdk.AccAddress, contract, sender common.Address) *MsgConvertERC20 { // nolint: interfacer
+	return &MsgConvertERC20{
+		ContractAddress: contract.String(),
+		Amount:          amount,
+		Receiver:        receiver.String(),
+		Sender:          sender.Hex(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgConvertERC20) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgConvertERC20) Type() string { return TypeMsgConvertERC20 }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConvertERC20) ValidateBasic() error {
+	if err := ethermint.ValidateAddress(msg.ContractAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid ERC20 contract address")
+	}
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "cannot mint a non-positive amount")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid receiver address")
+	}
+	if !common.IsHexAddress(msg.Sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender hex address %s", msg.Sender)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgConvertERC20) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgConvertERC20) GetSigners() []sdk.AccAddress {
+	addr := common.HexToAddress(msg.Sender)
+	return []sdk.AccAddress{addr.Bytes()}
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// Parameter store key
+var (
+	ParamStoreKeyEnableErc20 = []byte("EnableErc20")
+)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(enableErc20 bool) Params {
+	return Params{
+		EnableErc20: enableErc20,
+	}
+}
+
+// DefaultParams returns default erc20 module parameters
+func DefaultParams() Params {
+	return Params{
+		EnableErc20: true,
+	}
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyEnableErc20, &p.EnableErc20, validateBool),
+	}
+}
+
+// Validate performs a stateless validation of the erc20 module parameters.
+func (p Params) Validate() error {
+	return nil
+}
+
+func validateBool(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package evmfeegrant
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	evmostypes "github.com/tharsis/evmos/types"
+	"github.com/tharsis/evmos/x/evmfeegrant/keeper"
+	"github.com/tharsis/evmos/x/evmfeegrant/types"
+)
+
+// FeeGrantDecorator pays the fees of the Ethereum transactions that set a fee
+// granter with the granter's fee allowance, which the Ethermint AnteHandler
+// ignores. The granted fee is sent to the sender before it is deducted and
+// the fee of the unused gas is returned to the granter once the transaction
+// has been executed. The Cosmos transactions are left to the SDK fee grants.
+//
+// The fee granter of the Cosmos transaction wrapping an Ethereum transaction
+// is not signed by the sender, so the granter must also be listed in the
+// access list of the Ethereum transaction.
+//
+// NOTE: the decorator must run after the signature verification of the
+// Ethereum AnteHandler, which authenticates the sender.
+type FeeGrantDecorator struct {
+	keeper keeper.Keeper
+}
+
+// NewFeeGrantDecorator creates a new FeeGrantDecorator
+func NewFeeGrantDecorator(k keeper.Keeper) FeeGrantDecorator {
+	return FeeGrantDecorator{
+		keeper: k,
+	}
+}
+
+// AnteHandle uses the fee allowance of the granter of the Ethereum
+// transactions before calling the next AnteHandler.
+func (fgd FeeGrantDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.FeeGranter().Empty() {
+		return next(ctx, tx, simulate)
+	}
+
+	msgEthTx, sender, ok := evmostypes.GetEthereumTx(tx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	granter := feeTx.FeeGranter()
+	if !types.IsGranterSigned(msgEthTx.AsTransaction().AccessList(), granter) {
+		return ctx, sdkerrors.Wrapf(
+			types.ErrGranterNotSigned,
+			"the access list must contain the fee granter %s", common.BytesToAddress(granter),
+		)
+	}
+
+	if err := fgd.keeper.UseGrantedFees(ctx, granter, sender.Bytes(), msgEthTx); err != nil {
+		return ctx, sdkerrors.Wrapf(err, "%s does not allow to pay fees for %s", granter, sdk.AccAddress(sender.Bytes()))
+	}
+
+	return next(ctx, tx, simulate)
+}
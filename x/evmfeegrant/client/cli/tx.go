@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+
+	"github.com/tharsis/evmos/x/evmfeegrant/types"
+)
+
+// flags for the evmfeegrant module
+const (
+	FlagExpiration = "expiration"
+	FlagSpendLimit = "spend-limit"
+)
+
+// NewTxCmd returns a root CLI command handler for evmfeegrant transaction
+// commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "evmfeegrant subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewGrantContractsCmd(),
+	)
+	return txCmd
+}
+
+// NewGrantContractsCmd returns a CLI command handler for granting a fee
+// allowance restricted to the Ethereum transactions that call the given
+// contracts
+func NewGrantContractsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-contracts [granter_key_or_address] [grantee] [contracts_hex]",
+		Short: "Grant a fee allowance for the Ethereum transactions that call the given comma-separated contracts",
+		Long:  "Grant a fee allowance for the Ethereum transactions that call the given comma-separated contracts. Grants without contract restriction are created with the feegrant module. Note, the '--from' flag is ignored as it is implied from [granter].",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			spendLimit, err := cmd.Flags().GetString(FlagSpendLimit)
+			if err != nil {
+				return err
+			}
+
+			// the spend limit is nil if the flag isn't set
+			limit, err := sdk.ParseCoinsNormalized(spendLimit)
+			if err != nil {
+				return err
+			}
+
+			basic := &feegrant.BasicAllowance{
+				SpendLimit: limit,
+			}
+
+			expiration, err := cmd.Flags().GetString(FlagExpiration)
+			if err != nil {
+				return err
+			}
+
+			if expiration != "" {
+				expiresAt, err := time.Parse(time.RFC3339, expiration)
+				if err != nil {
+					return err
+				}
+				basic.Expiration = &expiresAt
+			}
+
+			allowance, err := types.NewContractAllowance(basic, strings.Split(args[2], ","))
+			if err != nil {
+				return err
+			}
+
+			msg, err := feegrant.NewMsgGrantAllowance(allowance, cliCtx.GetFromAddress(), grantee)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the grant expires")
+	cmd.Flags().String(FlagSpendLimit, "", "The maximum amount of fees that can be granted, unlimited if not set")
+	return cmd
+}
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/tharsis/evmos/x/evmfeegrant/types"
+)
+
+// GetTxFee returns the fee deducted from the sender of an Ethereum
+// transaction by the Ethermint AnteHandler, i.e. the gas limit times the
+// effective gas tip for dynamic fee transactions, or the gas price otherwise.
+func (k Keeper) GetTxFee(ctx sdk.Context, txData evmtypes.TxData) sdk.Coin {
+	params := k.evmKeeper.GetParams(ctx)
+	ethCfg := params.ChainConfig.EthereumConfig(k.evmKeeper.ChainID())
+
+	effectiveTip := txData.GetGasPrice()
+
+	london := evmtypes.IsLondon(ethCfg, ctx.BlockHeight())
+	if london && !k.feeMarketKeeper.GetParams(ctx).NoBaseFee && txData.TxType() == ethtypes.DynamicFeeTxType {
+		baseFee := k.feeMarketKeeper.GetBaseFee(ctx)
+		if baseFee == nil {
+			baseFee = common.Big0
+		}
+
+		gasFeeGap := new(big.Int).Sub(txData.GetGasFeeCap(), baseFee)
+		effectiveTip = math.BigMax(common.Big0, math.BigMin(txData.GetGasTipCap(), gasFeeGap))
+	}
+
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(txData.GetGas()), effectiveTip)
+	return sdk.NewCoin(params.EvmDenom, sdk.NewIntFromBigInt(fee))
+}
+
+// UseGrantedFees charges the granter's fee allowance with the fee of the
+// Ethereum transaction and sends it to the grantee, so that it can be
+// deducted by the Ethermint AnteHandler. The granted fee is kept until the
+// end of the transaction to return the fee of the unused gas to the granter.
+func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, msgEthTx *evmtypes.MsgEthereumTx) error {
+	txData, err := evmtypes.UnpackTxData(msgEthTx.Data)
+	if err != nil {
+		return err
+	}
+
+	fee := k.GetTxFee(ctx, txData)
+	if !fee.IsPositive() {
+		return nil
+	}
+
+	fees := sdk.Coins{fee}
+	if err := k.feeGrantKeeper.UseGrantedFees(ctx, granter, grantee, fees, []sdk.Msg{msgEthTx}); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, granter, grantee, fees); err != nil {
+		return err
+	}
+
+	grantedFee := types.GrantedFee{
+		Granter:  granter.String(),
+		Grantee:  grantee.String(),
+		Fee:      fee.Amount,
+		GasLimit: txData.GetGas(),
+	}
+
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.GetGrantedFeeKey(msgEthTx.AsTransaction().Hash()), k.cdc.MustMarshal(&grantedFee))
+	return nil
+}
+
+// RefundGrantedFees returns to the granter the fee of the gas that was not
+// used by the Ethereum transaction, which the EVM refunds to the sender. It
+// is a no-op if the fee of the transaction was not granted.
+func (k Keeper) RefundGrantedFees(ctx sdk.Context, msgEthTx *evmtypes.MsgEthereumTx, gasUsed uint64) error {
+	store := ctx.TransientStore(k.transientKey)
+	key := types.GetGrantedFeeKey(msgEthTx.AsTransaction().Hash())
+
+	bz := store.Get(key)
+	if bz == nil {
+		return nil
+	}
+
+	store.Delete(key)
+
+	var grantedFee types.GrantedFee
+	k.cdc.MustUnmarshal(bz, &grantedFee)
+
+	if gasUsed >= grantedFee.GasLimit {
+		return nil
+	}
+
+	unusedGas := sdk.NewIntFromUint64(grantedFee.GasLimit - gasUsed)
+	refund := grantedFee.Fee.Mul(unusedGas).Quo(sdk.NewIntFromUint64(grantedFee.GasLimit))
+	if !refund.IsPositive() {
+		return nil
+	}
+
+	granter, err := sdk.AccAddressFromBech32(grantedFee.Granter)
+	if err != nil {
+		return err
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(grantedFee.Grantee)
+	if err != nil {
+		return err
+	}
+
+	evmDenom := k.evmKeeper.GetParams(ctx).EvmDenom
+	return k.bankKeeper.SendCoins(ctx, grantee, granter, sdk.Coins{sdk.NewCoin(evmDenom, refund)})
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/tharsis/evmos/x/evmfeegrant/types"
+)
+
+// Keeper of this module pays the fees of the Ethereum transactions with the
+// fee allowances of the granters.
+type Keeper struct {
+	transientKey sdk.StoreKey
+	cdc          codec.BinaryCodec
+
+	bankKeeper      types.BankKeeper
+	feeGrantKeeper  types.FeeGrantKeeper
+	evmKeeper       types.EVMKeeper
+	feeMarketKeeper types.FeeMarketKeeper
+}
+
+// NewKeeper creates new instances of the evmfeegrant Keeper
+func NewKeeper(
+	transientKey sdk.StoreKey,
+	cdc codec.BinaryCodec,
+	bk types.BankKeeper,
+	fgk types.FeeGrantKeeper,
+	evmKeeper types.EVMKeeper,
+	fmk types.FeeMarketKeeper,
+) Keeper {
+	return Keeper{
+		transientKey:    transientKey,
+		cdc:             cdc,
+		bankKeeper:      bk,
+		feeGrantKeeper:  fgk,
+		evmKeeper:       evmKeeper,
+		feeMarketKeeper: fmk,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
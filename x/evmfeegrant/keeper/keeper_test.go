@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+
+	"github.com/tharsis/ethermint/tests"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/tharsis/evmos/app"
+	erc20types "github.com/tharsis/evmos/x/erc20/types"
+	"github.com/tharsis/evmos/x/evmfeegrant"
+	"github.com/tharsis/evmos/x/evmfeegrant/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx      sdk.Context
+	app      *app.Evmos
+	evmDenom string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.Setup(false, nil)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: "evmos_9000-1",
+		Time:    time.Now().UTC(),
+	})
+	suite.app.EvmKeeper.WithChainID(suite.ctx)
+	suite.evmDenom = suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+}
+
+func (suite *KeeperTestSuite) fund(addr sdk.AccAddress, coins sdk.Coins) {
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, erc20types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, erc20types.ModuleName, addr, coins))
+}
+
+func (suite *KeeperTestSuite) TestUseGrantedFees() {
+	decorator := evmfeegrant.NewFeeGrantDecorator(suite.app.EvmFeeGrantKeeper)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	granter := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	grantee, privKey := tests.NewAddrKey()
+	contract := tests.GenerateAddress()
+	other := tests.GenerateAddress()
+
+	suite.fund(granter, sdk.NewCoins(sdk.NewInt64Coin(suite.evmDenom, 100000)))
+
+	newSignedMsg := func(to common.Address, accessList *ethtypes.AccessList) *evmtypes.MsgEthereumTx {
+		msg := evmtypes.NewTx(suite.app.EvmKeeper.ChainID(), 0, &to, nil, 21000, big.NewInt(1), nil, nil, nil, accessList)
+		msg.From = grantee.Hex()
+		suite.Require().NoError(msg.Sign(ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID()), tests.NewSigner(privKey)))
+		return msg
+	}
+	newMsg := func(to common.Address) *evmtypes.MsgEthereumTx {
+		return newSignedMsg(to, &ethtypes.AccessList{{Address: common.BytesToAddress(granter)}})
+	}
+
+	// the granter is not signed
+	_, err := decorator.AnteHandle(suite.ctx, &testTx{msgs: []sdk.Msg{newSignedMsg(contract, nil)}, granter: granter}, false, next)
+	suite.Require().ErrorIs(err, types.ErrGranterNotSigned)
+	_, err = decorator.AnteHandle(suite.ctx, &testTx{msgs: []sdk.Msg{newSignedMsg(contract, &ethtypes.AccessList{{Address: other}})}, granter: granter}, false, next)
+	suite.Require().ErrorIs(err, types.ErrGranterNotSigned)
+
+	// no fee allowance
+	_, err = decorator.AnteHandle(suite.ctx, &testTx{msgs: []sdk.Msg{newMsg(contract)}, granter: granter}, false, next)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	basic := &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin(suite.evmDenom, 50000))}
+	allowance, err := types.NewContractAllowance(basic, []string{contract.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, granter, grantee.Bytes(), allowance))
+
+	// not an allowed contract
+	_, err = decorator.AnteHandle(suite.ctx, &testTx{msgs: []sdk.Msg{newMsg(other)}, granter: granter}, false, next)
+	suite.Require().ErrorIs(err, types.ErrContractNotAllowed)
+
+	msg := newMsg(contract)
+	_, err = decorator.AnteHandle(suite.ctx, &testTx{msgs: []sdk.Msg{msg}, granter: granter}, false, next)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(int64(79000), suite.app.BankKeeper.GetBalance(suite.ctx, granter, suite.evmDenom).Amount.Int64())
+	suite.Require().Equal(int64(21000), suite.app.BankKeeper.GetBalance(suite.ctx, grantee.Bytes(), suite.evmDenom).Amount.Int64())
+
+	grant, err := suite.app.FeeGrantKeeper.GetAllowance(suite.ctx, granter, grantee.Bytes())
+	suite.Require().NoError(err)
+	remaining, err := grant.(*types.ContractAllowance).GetAllowance()
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(suite.evmDenom, 29000)), remaining.(*feegrant.BasicAllowance).SpendLimit)
+
+	// the fee of the unused gas is returned to the granter once
+	suite.Require().NoError(suite.app.EvmFeeGrantKeeper.RefundGrantedFees(suite.ctx, msg, 14000))
+	suite.Require().NoError(suite.app.EvmFeeGrantKeeper.RefundGrantedFees(suite.ctx, msg, 14000))
+
+	suite.Require().Equal(int64(86000), suite.app.BankKeeper.GetBalance(suite.ctx, granter, suite.evmDenom).Amount.Int64())
+	suite.Require().Equal(int64(14000), suite.app.BankKeeper.GetBalance(suite.ctx, grantee.Bytes(), suite.evmDenom).Amount.Int64())
+}
+
+type testTx struct {
+	msgs    []sdk.Msg
+	granter sdk.AccAddress
+}
+
+func (tx *testTx) GetMsgs() []sdk.Msg         { return tx.msgs }
+func (tx *testTx) ValidateBasic() error       { return nil }
+func (tx *testTx) GetGas() uint64             { return 21000 }
+func (tx *testTx) GetFee() sdk.Coins          { return nil }
+func (tx *testTx) FeePayer() sdk.AccAddress   { return nil }
+func (tx *testTx) FeeGranter() sdk.AccAddress { return tx.granter }
@@ -0,0 +1,67 @@
+package evmfeegrant
+
+import (
+	"encoding/json"
+
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/tharsis/evmos/x/evmfeegrant/client/cli"
+	"github.com/tharsis/evmos/x/evmfeegrant/types"
+)
+
+var _ module.AppModuleBasic = AppModuleBasic{}
+
+// AppModuleBasic defines the basic application module used by the evmfeegrant
+// module. The module has no state, as the fee allowances are stored by the
+// feegrant module, so it only registers the ContractAllowance and its CLI
+// command.
+type AppModuleBasic struct{}
+
+// Name returns the evmfeegrant module's name.
+func (AppModuleBasic) Name() string {
+	return types.ModuleName
+}
+
+// RegisterLegacyAminoCodec performs a no-op as the evmfeegrant module doesn't
+// define messages.
+func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {}
+
+// RegisterInterfaces registers the ContractAllowance fee allowance.
+func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	types.RegisterInterfaces(registry)
+}
+
+// DefaultGenesis returns nil as the evmfeegrant module has no genesis state.
+func (AppModuleBasic) DefaultGenesis(_ codec.JSONCodec) json.RawMessage { return nil }
+
+// ValidateGenesis performs a no-op as the evmfeegrant module has no genesis
+// state.
+func (AppModuleBasic) ValidateGenesis(_ codec.JSONCodec, _ client.TxEncodingConfig, _ json.RawMessage) error {
+	return nil
+}
+
+// RegisterRESTRoutes performs a no-op as the evmfeegrant module doesn't expose
+// REST endpoints
+func (AppModuleBasic) RegisterRESTRoutes(_ client.Context, _ *mux.Router) {}
+
+// RegisterGRPCGatewayRoutes performs a no-op as the fee allowances are queried
+// with the feegrant module.
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(_ client.Context, _ *runtime.ServeMux) {}
+
+// GetTxCmd returns the root tx command for the evmfeegrant module.
+func (AppModuleBasic) GetTxCmd() *cobra.Command {
+	return cli.NewTxCmd()
+}
+
+// GetQueryCmd returns nil as the fee allowances are queried with the feegrant
+// module.
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return nil
+}
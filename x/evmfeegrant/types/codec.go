@@ -0,0 +1,19 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+// ModuleCdc references the global evmfeegrant module codec. Note, the codec
+// should ONLY be used in certain instances of tests and for JSON encoding.
+var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+// RegisterInterfaces registers the ContractAllowance as a fee allowance
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*feegrant.FeeAllowanceI)(nil),
+		&ContractAllowance{},
+	)
+}
@@ -0,0 +1,133 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+
+	ethermint "github.com/tharsis/ethermint/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+var (
+	_ feegrant.FeeAllowanceI             = (*ContractAllowance)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*ContractAllowance)(nil)
+)
+
+// NewContractAllowance creates a new ContractAllowance that restricts the
+// given allowance to the calls of the allowed contracts
+func NewContractAllowance(allowance feegrant.FeeAllowanceI, allowedContracts []string) (*ContractAllowance, error) {
+	a := &ContractAllowance{
+		AllowedContracts: allowedContracts,
+	}
+
+	if err := a.SetAllowance(allowance); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *ContractAllowance) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var allowance feegrant.FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// GetAllowance returns the restricted fee allowance
+func (a *ContractAllowance) GetAllowance() (feegrant.FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(feegrant.FeeAllowanceI)
+	if !ok {
+		return nil, sdkerrors.Wrap(feegrant.ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// Accept checks that all the messages are Ethereum transactions calling an
+// allowed contract before delegating to the restricted allowance. Contract
+// creations are never allowed.
+func (a *ContractAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	allowed := make(map[common.Address]bool, len(a.AllowedContracts))
+	for _, contract := range a.AllowedContracts {
+		allowed[common.HexToAddress(contract)] = true
+	}
+
+	for _, msg := range msgs {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return false, sdkerrors.Wrapf(ErrContractNotAllowed, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+
+		to := msgEthTx.AsTransaction().To()
+		if to == nil {
+			return false, sdkerrors.Wrap(ErrContractNotAllowed, "contract creations are not allowed")
+		}
+
+		if !allowed[*to] {
+			return false, sdkerrors.Wrapf(ErrContractNotAllowed, "%s is not an allowed contract", to)
+		}
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if remove || err != nil {
+		return remove, err
+	}
+
+	// the restricted allowance is packed again so that its updated state,
+	// e.g. the remaining spend limit, is stored along with the grant
+	if err := a.SetAllowance(allowance); err != nil {
+		return false, err
+	}
+
+	return false, nil
+}
+
+// SetAllowance sets the restricted fee allowance
+func (a *ContractAllowance) SetAllowance(allowance feegrant.FeeAllowanceI) error {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+
+	any, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return err
+	}
+
+	a.Allowance = any
+	return nil
+}
+
+// ValidateBasic performs a stateless validation of the ContractAllowance
+func (a *ContractAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return sdkerrors.Wrap(feegrant.ErrNoAllowance, "allowance should not be empty")
+	}
+
+	if len(a.AllowedContracts) == 0 {
+		return sdkerrors.Wrap(ErrContractNotAllowed, "allowed contracts shouldn't be empty")
+	}
+
+	for _, contract := range a.AllowedContracts {
+		if err := ethermint.ValidateAddress(contract); err != nil {
+			return sdkerrors.Wrapf(err, "invalid allowed contract %s", contract)
+		}
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+
+	"github.com/tharsis/ethermint/tests"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+func TestContractAllowanceValidateBasic(t *testing.T) {
+	contract := tests.GenerateAddress().Hex()
+	basic := &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("aphoton", 100))}
+
+	testCases := []struct {
+		name             string
+		allowance        feegrant.FeeAllowanceI
+		allowedContracts []string
+		expPass          bool
+	}{
+		{"valid", basic, []string{contract}, true},
+		{"no allowed contracts", basic, nil, false},
+		{"invalid contract", basic, []string{"evmos1"}, false},
+		{"invalid allowance", &feegrant.BasicAllowance{SpendLimit: sdk.Coins{sdk.Coin{Denom: "aphoton", Amount: sdk.NewInt(-1)}}}, []string{contract}, false},
+	}
+
+	for _, tc := range testCases {
+		allowance, err := NewContractAllowance(tc.allowance, tc.allowedContracts)
+		require.NoError(t, err, tc.name)
+
+		err = allowance.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestContractAllowanceAccept(t *testing.T) {
+	ctx := sdk.Context{}.WithBlockHeader(tmproto.Header{Time: time.Now().UTC()})
+	chainID := big.NewInt(9000)
+	contract := tests.GenerateAddress()
+	other := tests.GenerateAddress()
+	fee := sdk.NewCoins(sdk.NewInt64Coin("aphoton", 40))
+
+	newMsg := func(to *common.Address) sdk.Msg {
+		return evmtypes.NewTx(chainID, 0, to, nil, 21000, big.NewInt(1), nil, nil, nil, nil)
+	}
+
+	testCases := []struct {
+		name    string
+		msgs    []sdk.Msg
+		expPass bool
+	}{
+		{"allowed contract", []sdk.Msg{newMsg(&contract)}, true},
+		{"not an allowed contract", []sdk.Msg{newMsg(&other)}, false},
+		{"contract creation", []sdk.Msg{newMsg(nil)}, false},
+		{"cosmos message", []sdk.Msg{&banktypes.MsgSend{}}, false},
+	}
+
+	for _, tc := range testCases {
+		basic := &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("aphoton", 100))}
+		allowance, err := NewContractAllowance(basic, []string{contract.Hex()})
+		require.NoError(t, err, tc.name)
+
+		remove, err := allowance.Accept(ctx, fee, tc.msgs)
+		require.False(t, remove, tc.name)
+		if !tc.expPass {
+			require.ErrorIs(t, err, ErrContractNotAllowed, tc.name)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+
+		// the updated spend limit is stored along with the allowance
+		updated := &feegrant.BasicAllowance{}
+		require.NoError(t, updated.Unmarshal(allowance.Allowance.Value), tc.name)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("aphoton", 60)), updated.SpendLimit, tc.name)
+	}
+}
@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// errors
+var (
+	ErrContractNotAllowed = sdkerrors.Register(ModuleName, 2, "contract not allowed")
+	ErrGranterNotSigned   = sdkerrors.Register(ModuleName, 3, "fee granter not signed by the ethereum transaction")
+)
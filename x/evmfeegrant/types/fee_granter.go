@@ -0,0 +1,23 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsGranterSigned returns true if the access list of an Ethereum transaction
+// contains the address of the fee granter, which binds the fee grant to the
+// signature of the sender.
+func IsGranterSigned(accessList ethtypes.AccessList, granter sdk.AccAddress) bool {
+	granterAddr := common.BytesToAddress(granter)
+
+	for _, tuple := range accessList {
+		if tuple.Address == granterAddr {
+			return true
+		}
+	}
+
+	return false
+}
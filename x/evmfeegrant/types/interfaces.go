@@ -0,0 +1,35 @@
+package types
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+	feemarkettypes "github.com/tharsis/ethermint/x/feemarket/types"
+)
+
+// BankKeeper defines the expected interface needed to send the granted fees.
+type BankKeeper interface {
+	SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// FeeGrantKeeper defines the expected fee grant keeper interface used to
+// charge the fee allowances.
+type FeeGrantKeeper interface {
+	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
+}
+
+// EVMKeeper defines the expected EVM keeper interface used to compute the
+// fees of the Ethereum transactions.
+type EVMKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+	ChainID() *big.Int
+}
+
+// FeeMarketKeeper defines the expected fee market keeper interface used to
+// compute the fees of the Ethereum transactions.
+type FeeMarketKeeper interface {
+	GetParams(ctx sdk.Context) feemarkettypes.Params
+	GetBaseFee(ctx sdk.Context) *big.Int
+}
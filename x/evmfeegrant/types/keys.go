@@ -0,0 +1,35 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// constants
+const (
+	// module name
+	ModuleName = "evmfeegrant"
+
+	// TransientKey is the key to access the evmfeegrant transient store, that
+	// is reset during the Commit phase. It must not be prefixed with the EVM
+	// transient key "transient_evm".
+	TransientKey = "transient_feegrant_evm"
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the evmfeegrant transient store
+const (
+	prefixTransientGrantedFee = iota + 1
+)
+
+// Transient Store key prefixes
+var (
+	KeyPrefixTransientGrantedFee = []byte{prefixTransientGrantedFee}
+)
+
+// GetGrantedFeeKey returns the transient key of the fee granted to the given
+// Ethereum transaction
+func GetGrantedFeeKey(txHash common.Hash) []byte {
+	return append(KeyPrefixTransientGrantedFee, txHash.Bytes()...)
+}
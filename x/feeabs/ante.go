@@ -0,0 +1,184 @@
+package feeabs
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	evmostypes "github.com/tharsis/evmos/types"
+	"github.com/tharsis/evmos/x/feeabs/keeper"
+	"github.com/tharsis/evmos/x/feeabs/types"
+)
+
+// FeeAbstractionDecorator allows the transactions to pay their fees with the
+// governance-approved fee tokens, accounted at their equivalent amount of the
+// EVM denomination:
+//   - Cosmos transactions pay the fee tokens to the fee collector, as any
+//     other fee.
+//   - Ethereum transactions are charged in the EVM denomination, so the fee
+//     tokens set on the transaction fee are first exchanged for their
+//     equivalent amount by the EthFeeAbstractionDecorator.
+//
+// NOTE: the decorator must be composed around the Ethermint AnteHandler, as the
+// minimum gas prices are checked against the equivalent fees instead of the
+// fee tokens themselves.
+type FeeAbstractionDecorator struct {
+	keeper    keeper.Keeper
+	evmKeeper types.EVMKeeper
+}
+
+// NewFeeAbstractionDecorator creates a new FeeAbstractionDecorator
+func NewFeeAbstractionDecorator(k keeper.Keeper, evmKeeper types.EVMKeeper) FeeAbstractionDecorator {
+	return FeeAbstractionDecorator{
+		keeper:    k,
+		evmKeeper: evmKeeper,
+	}
+}
+
+// AnteHandle checks the fees paid with fee tokens against the minimum gas
+// prices of the EVM denomination during CheckTx before calling the next
+// AnteHandler.
+func (fad FeeAbstractionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	params := fad.keeper.GetParams(ctx)
+	if !params.EnableFeeAbstraction {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	evmDenom := fad.evmKeeper.GetParams(ctx).EvmDenom
+
+	fees := feeTx.GetFee()
+	feeTokens, equivalent := params.ConvertFees(fees, evmDenom)
+	if feeTokens.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	minGasPrices := ctx.MinGasPrices()
+	minGasPrice := minGasPrices.AmountOf(evmDenom)
+
+	if ctx.IsCheckTx() && !simulate && minGasPrice.IsPositive() {
+		gasLimit := sdk.NewDec(int64(feeTx.GetGas()))
+		requiredFee := minGasPrice.Mul(gasLimit).Ceil().RoundInt()
+		totalFee := fees.AmountOf(evmDenom).Add(equivalent)
+
+		if totalFee.LT(requiredFee) {
+			return ctx, sdkerrors.Wrapf(
+				sdkerrors.ErrInsufficientFee,
+				"insufficient fees; got: %s equivalent to %s%s required: %s%s", fees, totalFee, evmDenom, requiredFee, evmDenom,
+			)
+		}
+
+		// the minimum gas prices have been checked, the Ethermint AnteHandler
+		// would otherwise reject the fee tokens
+		ctx = ctx.WithMinGasPrices(sdk.DecCoins{})
+	}
+
+	newCtx, err := next(ctx, tx, simulate)
+	return newCtx.WithMinGasPrices(minGasPrices), err
+}
+
+// EthFeeAbstractionDecorator exchanges the fee tokens set on the fee of the
+// Ethereum transactions for their equivalent amount of the EVM denomination,
+// provided by the module account, so that the Ethereum AnteHandler deducts
+// them from the sender. Only the fee tokens worth the gas limit at the
+// effective gas price are exchanged, the others are left to the sender. The
+// gas refund of the exchanged amount stays with the sender in the EVM
+// denomination.
+//
+// The fee of the Cosmos transaction wrapping an Ethereum transaction is not
+// signed by the sender, so each fee token must also be signed in the access
+// list of the Ethereum transaction, with its FeeTokenStorageKey under the
+// ModuleEthAddress.
+//
+// NOTE: the decorator must run after the signature verification of the
+// Ethereum AnteHandler, which authenticates the sender.
+type EthFeeAbstractionDecorator struct {
+	keeper          keeper.Keeper
+	evmKeeper       types.EVMKeeper
+	feeMarketKeeper types.FeeMarketKeeper
+}
+
+// NewEthFeeAbstractionDecorator creates a new EthFeeAbstractionDecorator
+func NewEthFeeAbstractionDecorator(k keeper.Keeper, evmKeeper types.EVMKeeper, fmk types.FeeMarketKeeper) EthFeeAbstractionDecorator {
+	return EthFeeAbstractionDecorator{
+		keeper:          k,
+		evmKeeper:       evmKeeper,
+		feeMarketKeeper: fmk,
+	}
+}
+
+// AnteHandle exchanges the fee tokens of the Ethereum transactions before
+// calling the next AnteHandler.
+func (efad EthFeeAbstractionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	params := efad.keeper.GetParams(ctx)
+	if !params.EnableFeeAbstraction {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	msgEthTx, sender, ok := evmostypes.GetEthereumTx(tx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	evmParams := efad.evmKeeper.GetParams(ctx)
+	evmDenom := evmParams.EvmDenom
+
+	feeTokens, equivalent := params.ConvertFees(feeTx.GetFee(), evmDenom)
+	if feeTokens.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	if equivalent.IsZero() {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrInsufficientFee,
+			"fee tokens %s are not worth any %s", feeTokens, evmDenom,
+		)
+	}
+
+	ethTx := msgEthTx.AsTransaction()
+	for _, feeToken := range feeTokens {
+		if !types.IsFeeTokenSigned(ethTx.AccessList(), feeToken.Denom) {
+			return ctx, sdkerrors.Wrapf(
+				types.ErrFeeTokenNotSigned,
+				"the access list must contain the storage key %s of %s under %s",
+				types.FeeTokenStorageKey(feeToken.Denom), feeToken.Denom, types.ModuleEthAddress,
+			)
+		}
+	}
+
+	// only the fee tokens worth the gas limit at the effective gas price are
+	// exchanged
+	gasPrice := ethTx.GasPrice()
+
+	ethCfg := evmParams.ChainConfig.EthereumConfig(efad.evmKeeper.ChainID())
+	if evmtypes.IsLondon(ethCfg, ctx.BlockHeight()) {
+		if baseFee := efad.feeMarketKeeper.GetBaseFee(ctx); baseFee != nil {
+			gasPrice = math.BigMin(new(big.Int).Add(ethTx.GasTipCap(), baseFee), ethTx.GasFeeCap())
+		}
+	}
+
+	maxFee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(ethTx.Gas()))
+	feeTokens, equivalent = params.CapFeeTokens(feeTokens, sdk.NewIntFromBigInt(maxFee))
+
+	if equivalent.IsPositive() {
+		if err := efad.keeper.SwapFeeTokens(ctx, sender.Bytes(), feeTokens, sdk.NewCoin(evmDenom, equivalent)); err != nil {
+			return ctx, sdkerrors.Wrap(err, "failed to swap fee tokens")
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,31 @@
+package feeabs
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+
+	"github.com/tharsis/evmos/x/feeabs/keeper"
+	"github.com/tharsis/evmos/x/feeabs/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	accountKeeper authkeeper.AccountKeeper,
+	data types.GenesisState,
+) {
+	k.SetParams(ctx, data.Params)
+
+	// ensure feeabs module account is set on genesis
+	if acc := accountKeeper.GetModuleAccount(ctx, types.ModuleName); acc == nil {
+		panic("the feeabs module account has not been set")
+	}
+}
+
+// ExportGenesis export module status
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params: k.GetParams(ctx),
+	}
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/x/feeabs/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// FeeToken returns the accepted fee token with the given denomination
+func (k Keeper) FeeToken(c context.Context, req *types.QueryFeeTokenRequest) (*types.QueryFeeTokenResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	feeToken, found := k.GetParams(ctx).GetFeeToken(req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "fee token with denom '%s'", req.Denom)
+	}
+
+	return &types.QueryFeeTokenResponse{FeeToken: feeToken}, nil
+}
+
+// Params returns the feeabs module parameters
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
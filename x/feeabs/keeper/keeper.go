@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/tharsis/evmos/x/feeabs/types"
+)
+
+// Keeper of this module maintains the governance-approved fee tokens and
+// exchanges them for the EVM denomination.
+type Keeper struct {
+	paramstore paramtypes.Subspace
+
+	bankKeeper types.BankKeeper
+	evmKeeper  types.EVMKeeper
+}
+
+// NewKeeper creates new instances of the feeabs Keeper
+func NewKeeper(
+	ps paramtypes.Subspace,
+	bk types.BankKeeper,
+	evmKeeper types.EVMKeeper,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		paramstore: ps,
+		bankKeeper: bk,
+		evmKeeper:  evmKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
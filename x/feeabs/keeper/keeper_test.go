@@ -0,0 +1,214 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tharsis/ethermint/tests"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/tharsis/evmos/app"
+	"github.com/tharsis/evmos/app/ante"
+	erc20types "github.com/tharsis/evmos/x/erc20/types"
+	"github.com/tharsis/evmos/x/feeabs"
+	"github.com/tharsis/evmos/x/feeabs/types"
+)
+
+const feeDenom = "uatom"
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx      sdk.Context
+	app      *app.Evmos
+	evmDenom string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.Setup(false, nil)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: "evmos_9000-1",
+		Time:    time.Now().UTC(),
+	})
+	suite.app.EvmKeeper.WithChainID(suite.ctx)
+	suite.evmDenom = suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+
+	suite.app.FeeAbsKeeper.SetParams(suite.ctx, types.NewParams(true, types.NewFeeToken(feeDenom, sdk.NewDec(2))))
+}
+
+func (suite *KeeperTestSuite) fund(addr sdk.AccAddress, coins sdk.Coins) {
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, erc20types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, erc20types.ModuleName, addr, coins))
+}
+
+func (suite *KeeperTestSuite) TestMinGasPrices() {
+	decorator := feeabs.NewFeeAbstractionDecorator(suite.app.FeeAbsKeeper, suite.app.EvmKeeper)
+
+	var nextMinGasPrices sdk.DecCoins
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		nextMinGasPrices = ctx.MinGasPrices()
+		return ctx, nil
+	}
+
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoin(suite.evmDenom, sdk.NewInt(1)))
+	ctx := suite.ctx.WithIsCheckTx(true).WithMinGasPrices(minGasPrices)
+
+	testCases := []struct {
+		name            string
+		fee             sdk.Coins
+		expPass         bool
+		expMinGasPrices sdk.DecCoins
+	}{
+		{"fee tokens", sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 50)), true, sdk.DecCoins{}},
+		{"fee tokens and evm denom", sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 40), sdk.NewInt64Coin(suite.evmDenom, 20)), true, sdk.DecCoins{}},
+		{"insufficient fee tokens", sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 40)), false, nil},
+		{"not a fee token", sdk.NewCoins(sdk.NewInt64Coin("uosmo", 100)), true, minGasPrices},
+	}
+
+	for _, tc := range testCases {
+		nextMinGasPrices = nil
+		tx := &testTx{fee: tc.fee, gas: 100}
+
+		newCtx, err := decorator.AnteHandle(ctx, tx, false, next)
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+			suite.Require().Equal(tc.expMinGasPrices, nextMinGasPrices, tc.name)
+			suite.Require().Equal(minGasPrices, newCtx.MinGasPrices(), tc.name)
+		} else {
+			suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee, tc.name)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestSwapFeeTokens() {
+	// the sender is authenticated by the signature verification
+	anteHandler := sdk.ChainAnteDecorators(
+		ante.NewEthSigVerificationDecorator(suite.app.EvmKeeper),
+		feeabs.NewEthFeeAbstractionDecorator(suite.app.FeeAbsKeeper, suite.app.EvmKeeper, suite.app.FeeMarketKeeper),
+	)
+
+	sender, privKey := tests.NewAddrKey()
+	other := tests.GenerateAddress()
+	fee := sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 50))
+	suite.fund(sender.Bytes(), fee)
+
+	newSignedTx := func(accessList *ethtypes.AccessList) sdk.Tx {
+		to := tests.GenerateAddress()
+		msg := evmtypes.NewTx(suite.app.EvmKeeper.ChainID(), 0, &to, nil, 21000, big.NewInt(1), nil, nil, nil, accessList)
+		msg.From = sender.Hex()
+		suite.Require().NoError(msg.Sign(ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID()), tests.NewSigner(privKey)))
+
+		// the from field is set to the signer
+		msg.From = other.Hex()
+		return &testTx{msgs: []sdk.Msg{msg}, fee: fee, gas: 21000}
+	}
+	newTx := func() sdk.Tx { return newSignedTx(feeTokenAccessList()) }
+
+	// the fee tokens are not signed
+	_, err := anteHandler(suite.ctx, newSignedTx(nil), false)
+	suite.Require().ErrorIs(err, types.ErrFeeTokenNotSigned)
+	_, err = anteHandler(suite.ctx, newSignedTx(&ethtypes.AccessList{{Address: types.ModuleEthAddress}}), false)
+	suite.Require().ErrorIs(err, types.ErrFeeTokenNotSigned)
+
+	// no liquidity
+	_, err = anteHandler(suite.ctx, newTx(), false)
+	suite.Require().ErrorIs(err, types.ErrInsufficientLiquidity)
+
+	suite.fund(types.ModuleAddress, sdk.NewCoins(sdk.NewInt64Coin(suite.evmDenom, 1000)))
+
+	_, err = anteHandler(suite.ctx, newTx(), false)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(int64(100), suite.app.BankKeeper.GetBalance(suite.ctx, sender.Bytes(), suite.evmDenom).Amount.Int64())
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, sender.Bytes(), feeDenom).IsZero())
+	suite.Require().Equal(int64(50), suite.app.BankKeeper.GetBalance(suite.ctx, types.ModuleAddress, feeDenom).Amount.Int64())
+	suite.Require().Equal(int64(900), suite.app.BankKeeper.GetBalance(suite.ctx, types.ModuleAddress, suite.evmDenom).Amount.Int64())
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, other.Bytes(), suite.evmDenom).IsZero())
+
+	// disabled fee abstraction
+	suite.app.FeeAbsKeeper.SetParams(suite.ctx, types.DefaultParams())
+	_, err = anteHandler(suite.ctx, newTx(), false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(100), suite.app.BankKeeper.GetBalance(suite.ctx, sender.Bytes(), suite.evmDenom).Amount.Int64())
+}
+
+func (suite *KeeperTestSuite) TestSwapOversizedFee() {
+	// the sender is authenticated by the signature verification
+	anteHandler := sdk.ChainAnteDecorators(
+		ante.NewEthSigVerificationDecorator(suite.app.EvmKeeper),
+		feeabs.NewEthFeeAbstractionDecorator(suite.app.FeeAbsKeeper, suite.app.EvmKeeper, suite.app.FeeMarketKeeper),
+	)
+
+	sender, privKey := tests.NewAddrKey()
+	fee := sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 50000))
+	suite.fund(sender.Bytes(), fee)
+	suite.fund(types.ModuleAddress, sdk.NewCoins(sdk.NewInt64Coin(suite.evmDenom, 1000000)))
+
+	newTx := func(nonce, gasLimit uint64) sdk.Tx {
+		to := tests.GenerateAddress()
+		msg := evmtypes.NewTx(suite.app.EvmKeeper.ChainID(), nonce, &to, nil, gasLimit, big.NewInt(1), nil, nil, nil, feeTokenAccessList())
+		msg.From = sender.Hex()
+		suite.Require().NoError(msg.Sign(ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID()), tests.NewSigner(privKey)))
+		return &testTx{msgs: []sdk.Msg{msg}, fee: fee, gas: gasLimit}
+	}
+
+	// the fee tokens are worth 100000, only gas limit * gas price is swapped
+	_, err := anteHandler(suite.ctx, newTx(0, 21000), false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(21000), suite.app.BankKeeper.GetBalance(suite.ctx, sender.Bytes(), suite.evmDenom).Amount.Int64())
+	suite.Require().Equal(int64(39500), suite.app.BankKeeper.GetBalance(suite.ctx, sender.Bytes(), feeDenom).Amount.Int64())
+	suite.Require().Equal(int64(10500), suite.app.BankKeeper.GetBalance(suite.ctx, types.ModuleAddress, feeDenom).Amount.Int64())
+
+	// the fee tokens are rounded up
+	_, err = anteHandler(suite.ctx, newTx(1, 21001), false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(42001), suite.app.BankKeeper.GetBalance(suite.ctx, sender.Bytes(), suite.evmDenom).Amount.Int64())
+	suite.Require().Equal(int64(28999), suite.app.BankKeeper.GetBalance(suite.ctx, sender.Bytes(), feeDenom).Amount.Int64())
+}
+
+func (suite *KeeperTestSuite) TestFeeTokenQuery() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := suite.app.FeeAbsKeeper.FeeToken(ctx, &types.QueryFeeTokenRequest{Denom: feeDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewFeeToken(feeDenom, sdk.NewDec(2)), res.FeeToken)
+
+	_, err = suite.app.FeeAbsKeeper.FeeToken(ctx, &types.QueryFeeTokenRequest{Denom: "uosmo"})
+	suite.Require().Error(err)
+}
+
+// feeTokenAccessList returns the access list that signs the fee token
+func feeTokenAccessList() *ethtypes.AccessList {
+	return &ethtypes.AccessList{{
+		Address:     types.ModuleEthAddress,
+		StorageKeys: []common.Hash{types.FeeTokenStorageKey(feeDenom)},
+	}}
+}
+
+type testTx struct {
+	msgs []sdk.Msg
+	fee  sdk.Coins
+	gas  uint64
+}
+
+func (tx *testTx) GetMsgs() []sdk.Msg         { return tx.msgs }
+func (tx *testTx) ValidateBasic() error       { return nil }
+func (tx *testTx) GetGas() uint64             { return tx.gas }
+func (tx *testTx) GetFee() sdk.Coins          { return tx.fee }
+func (tx *testTx) FeePayer() sdk.AccAddress   { return nil }
+func (tx *testTx) FeeGranter() sdk.AccAddress { return nil }
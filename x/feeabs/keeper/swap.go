@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tharsis/evmos/x/feeabs/types"
+)
+
+// SwapFeeTokens exchanges the sender's fee tokens for the equivalent amount
+// of the EVM denomination, held by the module account. The fee tokens are
+// kept by the module account.
+func (k Keeper) SwapFeeTokens(ctx sdk.Context, sender sdk.AccAddress, feeTokens sdk.Coins, equivalent sdk.Coin) error {
+	liquidity := k.bankKeeper.GetBalance(ctx, types.ModuleAddress, equivalent.Denom)
+	if liquidity.IsLT(equivalent) {
+		return sdkerrors.Wrapf(
+			types.ErrInsufficientLiquidity,
+			"%s is smaller than %s", liquidity, equivalent,
+		)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, feeTokens); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.Coins{equivalent}); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapFeeTokens,
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyFeeTokens, feeTokens.String()),
+			sdk.NewAttribute(types.AttributeKeyEquivalent, equivalent.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// errors
+var (
+	ErrInsufficientLiquidity = sdkerrors.Register(ModuleName, 2, "insufficient fee abstraction liquidity")
+	ErrFeeTokenNotSigned     = sdkerrors.Register(ModuleName, 3, "fee token not signed by the ethereum transaction")
+)
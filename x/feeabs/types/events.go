@@ -0,0 +1,10 @@
+package types
+
+// feeabs events
+const (
+	EventTypeSwapFeeTokens = "swap_fee_tokens"
+
+	AttributeKeySender     = "sender"
+	AttributeKeyFeeTokens  = "fee_tokens"
+	AttributeKeyEquivalent = "equivalent"
+)
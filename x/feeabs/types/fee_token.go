@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewFeeToken returns an instance of FeeToken
+func NewFeeToken(denom string, rate sdk.Dec) FeeToken {
+	return FeeToken{
+		Denom: denom,
+		Rate:  rate,
+	}
+}
+
+// Validate performs a stateless validation of a FeeToken
+func (ft FeeToken) Validate() error {
+	if err := sdk.ValidateDenom(ft.Denom); err != nil {
+		return err
+	}
+
+	if ft.Rate.IsNil() || !ft.Rate.IsPositive() {
+		return fmt.Errorf("fee token %s rate must be positive: %s", ft.Denom, ft.Rate)
+	}
+
+	return nil
+}
+
+// Convert returns the amount of the EVM denomination equivalent to the given
+// amount of the fee token, truncated to an integer.
+func (ft FeeToken) Convert(amount sdk.Int) sdk.Int {
+	return ft.Rate.MulInt(amount).TruncateInt()
+}
+
+// FeeTokenStorageKey returns the storage key that an Ethereum transaction sets
+// under the ModuleEthAddress in its access list to pay its fee with the fee
+// token of the given denomination.
+func FeeTokenStorageKey(denom string) common.Hash {
+	return crypto.Keccak256Hash([]byte(denom))
+}
+
+// IsFeeTokenSigned returns true if the access list of an Ethereum transaction
+// opts in to pay its fee with the fee token of the given denomination.
+func IsFeeTokenSigned(accessList ethtypes.AccessList, denom string) bool {
+	storageKey := FeeTokenStorageKey(denom)
+
+	for _, tuple := range accessList {
+		if tuple.Address != ModuleEthAddress {
+			continue
+		}
+
+		for _, key := range tuple.StorageKeys {
+			if key == storageKey {
+				return true
+			}
+		}
+	}
+
+	return false
+}
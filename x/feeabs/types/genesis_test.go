@@ -0,0 +1,54 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expPass  bool
+	}{
+		{"default", DefaultGenesisState(), true},
+		{"fee tokens", &GenesisState{Params: NewParams(true, NewFeeToken(ibcDenom, sdk.NewDec(2)), NewFeeToken("erc20/0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd", sdk.NewDecWithPrec(5, 1)))}, true},
+		{"invalid denom", &GenesisState{Params: NewParams(true, NewFeeToken("1uatom", sdk.NewDec(2)))}, false},
+		{"zero rate", &GenesisState{Params: NewParams(true, NewFeeToken(ibcDenom, sdk.ZeroDec()))}, false},
+		{"negative rate", &GenesisState{Params: NewParams(true, NewFeeToken(ibcDenom, sdk.NewDec(-1)))}, false},
+		{"nil rate", &GenesisState{Params: NewParams(true, FeeToken{Denom: ibcDenom})}, false},
+		{"duplicated fee token", &GenesisState{Params: NewParams(true, NewFeeToken(ibcDenom, sdk.NewDec(2)), NewFeeToken(ibcDenom, sdk.NewDec(3)))}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestConvertFees(t *testing.T) {
+	params := NewParams(true, NewFeeToken("uatom", sdk.NewDecWithPrec(15, 1)), NewFeeToken("aphoton", sdk.NewDec(2)))
+
+	fees := sdk.NewCoins(
+		sdk.NewInt64Coin("aphoton", 100),
+		sdk.NewInt64Coin("uatom", 11),
+		sdk.NewInt64Coin("uosmo", 10),
+	)
+
+	feeTokens, equivalent := params.ConvertFees(fees, "aphoton")
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 11)), feeTokens)
+	require.Equal(t, sdk.NewInt(16), equivalent)
+
+	feeTokens, equivalent = params.ConvertFees(sdk.NewCoins(sdk.NewInt64Coin("aphoton", 100)), "aphoton")
+	require.True(t, feeTokens.IsZero())
+	require.True(t, equivalent.IsZero())
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+// BankKeeper defines the expected interface needed to exchange the fee tokens.
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
+
+// EVMKeeper defines the expected EVM keeper interface used to retrieve the
+// EVM denomination and to recover the sender of the Ethereum transactions.
+type EVMKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+	ChainID() *big.Int
+}
+
+// FeeMarketKeeper defines the expected fee market keeper interface used to
+// compute the effective gas price of the Ethereum transactions.
+type FeeMarketKeeper interface {
+	GetBaseFee(ctx sdk.Context) *big.Int
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// constants
+const (
+	// module name
+	ModuleName = "feeabs"
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// ModuleAddress is the address of the feeabs module account, which holds the
+// liquidity of the EVM denomination exchanged for the fee tokens
+var ModuleAddress = authtypes.NewModuleAddress(ModuleName)
+
+// ModuleEthAddress is the Ethereum address of the feeabs module account, under
+// which the Ethereum transactions sign the fee tokens they pay with in their
+// access list
+var ModuleEthAddress = common.BytesToAddress(ModuleAddress)
@@ -0,0 +1,159 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// Parameter store key
+var (
+	ParamStoreKeyEnableFeeAbstraction = []byte("EnableFeeAbstraction")
+	ParamStoreKeyFeeTokens            = []byte("FeeTokens")
+)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(enableFeeAbstraction bool, feeTokens ...FeeToken) Params {
+	if feeTokens == nil {
+		feeTokens = []FeeToken{}
+	}
+
+	return Params{
+		EnableFeeAbstraction: enableFeeAbstraction,
+		FeeTokens:            feeTokens,
+	}
+}
+
+// DefaultParams returns default feeabs module parameters. The fees can only
+// be paid with the EVM denomination by default.
+func DefaultParams() Params {
+	return Params{
+		EnableFeeAbstraction: false,
+		FeeTokens:            []FeeToken{},
+	}
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyEnableFeeAbstraction, &p.EnableFeeAbstraction, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyFeeTokens, &p.FeeTokens, validateFeeTokens),
+	}
+}
+
+// Validate performs a stateless validation of the feeabs module parameters.
+func (p Params) Validate() error {
+	if err := validateBool(p.EnableFeeAbstraction); err != nil {
+		return err
+	}
+
+	return validateFeeTokens(p.FeeTokens)
+}
+
+// GetFeeToken returns the accepted fee token with the given denomination.
+func (p Params) GetFeeToken(denom string) (FeeToken, bool) {
+	for _, feeToken := range p.FeeTokens {
+		if feeToken.Denom == denom {
+			return feeToken, true
+		}
+	}
+
+	return FeeToken{}, false
+}
+
+// ConvertFees returns the fee coins that are accepted fee tokens and the
+// amount of the EVM denomination they are equivalent to. The EVM denomination
+// itself is never considered a fee token.
+func (p Params) ConvertFees(fees sdk.Coins, evmDenom string) (sdk.Coins, sdk.Int) {
+	feeTokens := sdk.Coins{}
+	equivalent := sdk.ZeroInt()
+
+	for _, fee := range fees {
+		if fee.Denom == evmDenom {
+			continue
+		}
+
+		feeToken, found := p.GetFeeToken(fee.Denom)
+		if !found {
+			continue
+		}
+
+		feeTokens = feeTokens.Add(fee)
+		equivalent = equivalent.Add(feeToken.Convert(fee.Amount))
+	}
+
+	return feeTokens, equivalent
+}
+
+// CapFeeTokens returns the fee tokens worth at most the given amount of the
+// EVM denomination, and their equivalent amount. The fee tokens are taken in
+// order and the amount of the last one taken is rounded up, so that its
+// equivalent, capped to the given amount, is covered.
+func (p Params) CapFeeTokens(feeTokens sdk.Coins, max sdk.Int) (sdk.Coins, sdk.Int) {
+	capped := sdk.Coins{}
+	equivalent := sdk.ZeroInt()
+
+	for _, fee := range feeTokens {
+		remaining := max.Sub(equivalent)
+		if !remaining.IsPositive() {
+			break
+		}
+
+		feeToken, found := p.GetFeeToken(fee.Denom)
+		if !found {
+			continue
+		}
+
+		converted := feeToken.Convert(fee.Amount)
+		if converted.LTE(remaining) {
+			capped = capped.Add(fee)
+			equivalent = equivalent.Add(converted)
+			continue
+		}
+
+		amount := sdk.NewDecFromInt(remaining).QuoRoundUp(feeToken.Rate).Ceil().TruncateInt()
+		capped = capped.Add(sdk.NewCoin(fee.Denom, sdk.MinInt(amount, fee.Amount)))
+		equivalent = max
+	}
+
+	return capped, equivalent
+}
+
+func validateBool(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateFeeTokens(i interface{}) error {
+	feeTokens, ok := i.([]FeeToken)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, feeToken := range feeTokens {
+		if err := feeToken.Validate(); err != nil {
+			return fmt.Errorf("invalid fee token: %w", err)
+		}
+
+		if seen[feeToken.Denom] {
+			return fmt.Errorf("duplicated fee token %s", feeToken.Denom)
+		}
+
+		seen[feeToken.Denom] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package feeburn
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/x/feeburn/keeper"
+	"github.com/tharsis/evmos/x/feeburn/types"
+)
+
+// BeginBlocker records the base fee of the block, as the fee market module
+// updates it to the base fee of the next block in its EndBlocker
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.RecordBaseFee(ctx)
+}
+
+// EndBlocker burns and sends to the community pool their fractions of the
+// base fees paid by the Ethereum transactions of the block. The burn is
+// executed in a cached context so that a failure doesn't leave it partially
+// applied.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if !k.GetParams(ctx).IsEnabled() {
+		return
+	}
+
+	baseFees := k.GetBaseFees(ctx)
+	if !baseFees.IsPositive() {
+		return
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.BurnBaseFees(cacheCtx, baseFees); err != nil {
+		k.Logger(ctx).Error("failed to burn base fees", "error", err.Error())
+		return
+	}
+
+	writeCache()
+}
@@ -0,0 +1,26 @@
+package feeburn
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/x/feeburn/keeper"
+	"github.com/tharsis/evmos/x/feeburn/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data types.GenesisState,
+) {
+	k.SetParams(ctx, data.Params)
+	k.SetTotalBurned(ctx, data.TotalBurned)
+}
+
+// ExportGenesis export module status
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:      k.GetParams(ctx),
+		TotalBurned: k.GetTotalBurned(ctx),
+	}
+}
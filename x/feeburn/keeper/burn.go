@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/x/feeburn/types"
+)
+
+// RecordBaseFee stores the base fee of the current block, before it is
+// updated by the fee market module at the end of the block. Nothing is stored
+// if the base fee is disabled.
+func (k Keeper) RecordBaseFee(ctx sdk.Context) {
+	params := k.feeMarketKeeper.GetParams(ctx)
+	if !params.IsBaseFeeEnabled(ctx.BlockHeight()) {
+		return
+	}
+
+	baseFee := k.feeMarketKeeper.GetBaseFee(ctx)
+	if baseFee == nil {
+		return
+	}
+
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientBaseFee, baseFee.Bytes())
+}
+
+// GetRecordedBaseFee returns the base fee of the current block recorded at
+// its beginning, or nil if it hasn't been recorded.
+func (k Keeper) GetRecordedBaseFee(ctx sdk.Context) *big.Int {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.KeyPrefixTransientBaseFee)
+	if bz == nil {
+		return nil
+	}
+
+	return new(big.Int).SetBytes(bz)
+}
+
+// GetCollectedBaseFees returns the base fees of the gas used by an Ethereum
+// transaction that were collected by the fee collector. The Ethermint
+// AnteHandler deducts the gas price of the legacy and access list
+// transactions, which covers the base fee up to the gas price, but only the
+// effective gas tip of the dynamic fee transactions, whose base fees are
+// therefore never collected.
+func GetCollectedBaseFees(baseFee *big.Int, msg core.Message, receipt *ethtypes.Receipt) sdk.Int {
+	if receipt.Type == ethtypes.DynamicFeeTxType {
+		return sdk.ZeroInt()
+	}
+
+	collectedBaseFee := math.BigMin(baseFee, msg.GasPrice())
+	return sdk.NewIntFromBigInt(collectedBaseFee).Mul(sdk.NewIntFromUint64(receipt.GasUsed))
+}
+
+// GetBaseFees returns the base fees paid by the Ethereum transactions of the
+// current block.
+func (k Keeper) GetBaseFees(ctx sdk.Context) sdk.Int {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.KeyPrefixTransientBaseFees)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var baseFees sdk.Int
+	if err := baseFees.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return baseFees
+}
+
+// AddBaseFees adds the given amount to the base fees paid by the Ethereum
+// transactions of the current block.
+func (k Keeper) AddBaseFees(ctx sdk.Context, amount sdk.Int) {
+	bz, err := k.GetBaseFees(ctx).Add(amount).Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientBaseFees, bz)
+}
+
+// BurnBaseFees burns and sends to the community pool their respective
+// fractions of the given base fees, capped to the fees held by the fee
+// collector. The remaining fees are distributed to the stakers.
+func (k Keeper) BurnBaseFees(ctx sdk.Context, baseFees sdk.Int) error {
+	params := k.GetParams(ctx)
+	evmDenom := k.evmKeeper.GetParams(ctx).EvmDenom
+
+	feeCollector := k.accountKeeper.GetModuleAddress(k.feeCollectorName)
+	collected := k.bankKeeper.GetBalance(ctx, feeCollector, evmDenom)
+	baseFees = sdk.MinInt(baseFees, collected.Amount)
+
+	burned := sdk.NewCoin(evmDenom, params.BurnFraction.MulInt(baseFees).TruncateInt())
+	communityPool := sdk.NewCoin(evmDenom, params.CommunityPoolFraction.MulInt(baseFees).TruncateInt())
+
+	if burned.IsPositive() {
+		coins := sdk.Coins{burned}
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, coins); err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+
+		k.AddTotalBurned(ctx, burned)
+	}
+
+	if communityPool.IsPositive() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, sdk.Coins{communityPool}, feeCollector); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurnBaseFees,
+			sdk.NewAttribute(types.AttributeKeyBaseFees, sdk.NewCoin(evmDenom, baseFees).String()),
+			sdk.NewAttribute(types.AttributeKeyBurned, burned.String()),
+			sdk.NewAttribute(types.AttributeKeyCommunityPool, communityPool.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetTotalBurned returns the cumulative amount of burned base fees
+func (k Keeper) GetTotalBurned(ctx sdk.Context) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTotalBurned)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	totalBurned := sdk.Coins{}
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		totalBurned = totalBurned.Add(sdk.NewCoin(string(iterator.Key()), amount))
+	}
+
+	return totalBurned
+}
+
+// SetTotalBurned sets the cumulative amount of burned base fees
+func (k Keeper) SetTotalBurned(ctx sdk.Context, totalBurned sdk.Coins) {
+	for _, coin := range totalBurned {
+		k.setTotalBurnedAmount(ctx, coin)
+	}
+}
+
+// AddTotalBurned adds the given coin to the cumulative amount of burned base
+// fees
+func (k Keeper) AddTotalBurned(ctx sdk.Context, burned sdk.Coin) {
+	total := k.GetTotalBurned(ctx).AmountOf(burned.Denom).Add(burned.Amount)
+	k.setTotalBurnedAmount(ctx, sdk.NewCoin(burned.Denom, total))
+}
+
+func (k Keeper) setTotalBurnedAmount(ctx sdk.Context, coin sdk.Coin) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTotalBurned)
+	bz, err := coin.Amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set([]byte(coin.Denom), bz)
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PostTxProcessing implements the EVM post transaction hook. It adds the base
+// fees collected from the Ethereum transaction to the base fees burned at the
+// end of the block, so that the gas of the Cosmos transactions isn't taken
+// into account.
+//
+// NOTE: the hook isn't called for the failed Ethereum transactions, whose base
+// fees are therefore not burned.
+func (k Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	baseFee := k.GetRecordedBaseFee(ctx)
+	if baseFee == nil || baseFee.Sign() == 0 {
+		return nil
+	}
+
+	baseFees := GetCollectedBaseFees(baseFee, msg, receipt)
+	if baseFees.IsPositive() {
+		k.AddBaseFees(ctx, baseFees)
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/x/feeburn/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// TotalBurned returns the cumulative amount of burned base fees
+func (k Keeper) TotalBurned(c context.Context, _ *types.QueryTotalBurnedRequest) (*types.QueryTotalBurnedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryTotalBurnedResponse{TotalBurned: k.GetTotalBurned(ctx)}, nil
+}
+
+// Params returns the feeburn module parameters
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
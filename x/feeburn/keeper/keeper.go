@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/tharsis/evmos/x/feeburn/types"
+)
+
+// Keeper of this module burns a fraction of the base fees and keeps track of
+// the burned amount.
+type Keeper struct {
+	storeKey     sdk.StoreKey
+	transientKey sdk.StoreKey
+	paramstore   paramtypes.Subspace
+
+	accountKeeper    types.AccountKeeper
+	bankKeeper       types.BankKeeper
+	distrKeeper      types.DistrKeeper
+	feeMarketKeeper  types.FeeMarketKeeper
+	evmKeeper        types.EVMKeeper
+	feeCollectorName string
+}
+
+// NewKeeper creates new instances of the feeburn Keeper
+func NewKeeper(
+	storeKey, transientKey sdk.StoreKey,
+	ps paramtypes.Subspace,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	dk types.DistrKeeper,
+	fmk types.FeeMarketKeeper,
+	evmKeeper types.EVMKeeper,
+	feeCollector string,
+) Keeper {
+	// ensure the feeburn module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the feeburn module account has not been set")
+	}
+
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:         storeKey,
+		transientKey:     transientKey,
+		paramstore:       ps,
+		accountKeeper:    ak,
+		bankKeeper:       bk,
+		distrKeeper:      dk,
+		feeMarketKeeper:  fmk,
+		evmKeeper:        evmKeeper,
+		feeCollectorName: feeCollector,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
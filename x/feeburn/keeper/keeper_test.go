@@ -0,0 +1,226 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+	"github.com/tharsis/ethermint/tests"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+	feemarkettypes "github.com/tharsis/ethermint/x/feemarket/types"
+
+	"github.com/tharsis/evmos/app"
+	erc20types "github.com/tharsis/evmos/x/erc20/types"
+	"github.com/tharsis/evmos/x/feeburn"
+	"github.com/tharsis/evmos/x/feeburn/keeper"
+	"github.com/tharsis/evmos/x/feeburn/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx      sdk.Context
+	app      *app.Evmos
+	evmDenom string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.Setup(false, nil)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: "evmos_9000-1",
+		Time:    time.Now().UTC(),
+	})
+	suite.evmDenom = suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+
+	feeMarketParams := feemarkettypes.DefaultParams()
+	feeMarketParams.NoBaseFee = false
+	feeMarketParams.EnableHeight = 0
+	suite.app.FeeMarketKeeper.SetParams(suite.ctx, feeMarketParams)
+}
+
+func (suite *KeeperTestSuite) fundFeeCollector(amount int64) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(suite.evmDenom, amount))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, erc20types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, erc20types.ModuleName, authtypes.FeeCollectorName, coins))
+}
+
+func (suite *KeeperTestSuite) TestBurnBaseFees() {
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	testCases := []struct {
+		name             string
+		params           types.Params
+		collected        int64
+		expBurned        int64
+		expCommunityPool int64
+	}{
+		{"disabled", types.DefaultParams(), 2000, 0, 0},
+		{"burn and community pool", types.NewParams(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(25, 2)), 2000, 500, 250},
+		{"capped to the collected fees", types.NewParams(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(25, 2)), 300, 150, 75},
+		{"whole base fees burned", types.NewParams(sdk.OneDec(), sdk.ZeroDec()), 2000, 1000, 0},
+	}
+
+	for _, tc := range testCases {
+		suite.SetupTest()
+		suite.app.FeeBurnKeeper.SetParams(suite.ctx, tc.params)
+		suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, big.NewInt(10))
+		suite.fundFeeCollector(tc.collected)
+
+		supply := suite.app.BankKeeper.GetSupply(suite.ctx, suite.evmDenom).Amount
+		communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(suite.evmDenom)
+
+		feeburn.BeginBlocker(suite.ctx, suite.app.FeeBurnKeeper)
+		suite.Require().Equal(big.NewInt(10), suite.app.FeeBurnKeeper.GetRecordedBaseFee(suite.ctx), tc.name)
+
+		// two Ethereum transactions use 60 and 40 gas
+		msg := ethtypes.NewMessage(common.Address{}, nil, 0, big.NewInt(0), 100, big.NewInt(15), nil, nil, nil, nil, false)
+		for _, gasUsed := range []uint64{60, 40} {
+			err := suite.app.FeeBurnKeeper.PostTxProcessing(suite.ctx, msg, &ethtypes.Receipt{GasUsed: gasUsed})
+			suite.Require().NoError(err, tc.name)
+		}
+		suite.Require().Equal(sdk.NewInt(1000), suite.app.FeeBurnKeeper.GetBaseFees(suite.ctx), tc.name)
+
+		// the gas used by the Cosmos transactions is not taken into account
+		suite.app.FeeMarketKeeper.SetBlockGasUsed(suite.ctx, 500)
+
+		// the fee market updates the base fee of the next block
+		suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, big.NewInt(20))
+		feeburn.EndBlocker(suite.ctx, suite.app.FeeBurnKeeper)
+
+		expCollected := tc.collected - tc.expBurned - tc.expCommunityPool
+		suite.Require().Equal(expCollected, suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, suite.evmDenom).Amount.Int64(), tc.name)
+		suite.Require().Equal(supply.SubRaw(tc.expBurned), suite.app.BankKeeper.GetSupply(suite.ctx, suite.evmDenom).Amount, tc.name)
+		suite.Require().Equal(
+			communityPool.Add(sdk.NewDec(tc.expCommunityPool)),
+			suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(suite.evmDenom),
+			tc.name,
+		)
+		suite.Require().Equal(tc.expBurned, suite.app.FeeBurnKeeper.GetTotalBurned(suite.ctx).AmountOf(suite.evmDenom).Int64(), tc.name)
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetCollectedBaseFees() {
+	baseFee := big.NewInt(10)
+
+	testCases := []struct {
+		name        string
+		txType      uint8
+		gasPrice    int64
+		expBaseFees int64
+	}{
+		{"legacy tx", ethtypes.LegacyTxType, 15, 1000},
+		{"access list tx", ethtypes.AccessListTxType, 15, 1000},
+		{"gas price below the base fee", ethtypes.LegacyTxType, 5, 500},
+		{"dynamic fee tx", ethtypes.DynamicFeeTxType, 15, 0},
+	}
+
+	for _, tc := range testCases {
+		msg := ethtypes.NewMessage(common.Address{}, nil, 0, big.NewInt(0), 100, big.NewInt(tc.gasPrice), nil, nil, nil, nil, false)
+		receipt := &ethtypes.Receipt{Type: tc.txType, GasUsed: 100}
+		suite.Require().Equal(sdk.NewInt(tc.expBaseFees), keeper.GetCollectedBaseFees(baseFee, msg, receipt), tc.name)
+	}
+}
+
+// TestBurnDeliveredTxs checks that only the base fees collected from the
+// Ethereum transactions delivered to the app are burned. The AnteHandler
+// doesn't deduct the base fees of the dynamic fee transactions.
+func (suite *KeeperTestSuite) TestBurnDeliveredTxs() {
+	suite.app = app.Setup(false, nil)
+
+	consPriv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	header := tmproto.Header{
+		Height:          1,
+		ChainID:         "evmos_9000-1",
+		Time:            time.Now().UTC(),
+		ProposerAddress: consPriv.PubKey().Address(),
+	}
+	suite.ctx = suite.app.BaseApp.NewContext(false, header)
+	suite.evmDenom = suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+
+	feeMarketParams := feemarkettypes.DefaultParams()
+	feeMarketParams.NoBaseFee = false
+	feeMarketParams.EnableHeight = 0
+	suite.app.FeeMarketKeeper.SetParams(suite.ctx, feeMarketParams)
+	baseFee := big.NewInt(feemarkettypes.DefaultInitialBaseFee)
+	suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, baseFee)
+	suite.app.FeeBurnKeeper.SetParams(suite.ctx, types.NewParams(sdk.OneDec(), sdk.ZeroDec()))
+
+	// the EVM requires the block proposer to be a validator
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(tests.GenerateAddress().Bytes()), consPriv.PubKey(), stakingtypes.Description{})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.app.StakingKeeper.SetValidatorByConsAddr(suite.ctx, validator))
+	suite.app.StakingKeeper.SetValidator(suite.ctx, validator)
+
+	sender, privKey := tests.NewAddrKey()
+	coins := sdk.NewCoins(sdk.NewCoin(suite.evmDenom, sdk.NewInt(1000000000000000000)))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, erc20types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, erc20types.ModuleName, sender.Bytes(), coins))
+
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	collected := suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, suite.evmDenom).Amount
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, suite.evmDenom).Amount
+
+	suite.app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	to := tests.GenerateAddress()
+	chainID := suite.app.EvmKeeper.ChainID()
+	tip := big.NewInt(1000)
+	gasPrice := new(big.Int).Add(baseFee, tip)
+	gasFeeCap := new(big.Int).Mul(baseFee, big.NewInt(2))
+	msgs := []*evmtypes.MsgEthereumTx{
+		evmtypes.NewTx(chainID, 0, &to, big.NewInt(1), 21000, gasPrice, nil, nil, nil, nil),
+		evmtypes.NewTx(chainID, 1, &to, big.NewInt(1), 21000, nil, gasFeeCap, tip, nil, &ethtypes.AccessList{}),
+	}
+	for _, msg := range msgs {
+		msg.From = sender.Hex()
+		suite.Require().NoError(msg.Sign(ethtypes.LatestSignerForChainID(chainID), tests.NewSigner(privKey)))
+
+		res := app.DeliverEthTx(suite.app, msg)
+		suite.Require().True(res.IsOK(), res.Log)
+	}
+
+	// only the base fees of the legacy tx are collected
+	baseFees := sdk.NewIntFromBigInt(baseFee).MulRaw(21000)
+	suite.ctx = suite.app.BaseApp.NewContext(false, header)
+	suite.Require().Equal(baseFees, suite.app.FeeBurnKeeper.GetBaseFees(suite.ctx))
+
+	suite.app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+
+	tips := sdk.NewIntFromBigInt(tip).MulRaw(2 * 21000)
+	suite.Require().Equal(collected.Add(tips), suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, suite.evmDenom).Amount)
+	suite.Require().Equal(supply.Sub(baseFees), suite.app.BankKeeper.GetSupply(suite.ctx, suite.evmDenom).Amount)
+	suite.Require().Equal(baseFees, suite.app.FeeBurnKeeper.GetTotalBurned(suite.ctx).AmountOf(suite.evmDenom))
+}
+
+func (suite *KeeperTestSuite) TestTotalBurned() {
+	suite.app.FeeBurnKeeper.AddTotalBurned(suite.ctx, sdk.NewInt64Coin(suite.evmDenom, 100))
+	suite.app.FeeBurnKeeper.AddTotalBurned(suite.ctx, sdk.NewInt64Coin(suite.evmDenom, 50))
+
+	res, err := suite.app.FeeBurnKeeper.TotalBurned(sdk.WrapSDKContext(suite.ctx), &types.QueryTotalBurnedRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(suite.evmDenom, 150)), res.TotalBurned)
+
+	genState := feeburn.ExportGenesis(suite.ctx, suite.app.FeeBurnKeeper)
+	suite.SetupTest()
+	feeburn.InitGenesis(suite.ctx, suite.app.FeeBurnKeeper, *genState)
+	suite.Require().Equal(res.TotalBurned, suite.app.FeeBurnKeeper.GetTotalBurned(suite.ctx))
+}
@@ -0,0 +1,10 @@
+package types
+
+// feeburn events
+const (
+	EventTypeBurnBaseFees = "burn_base_fees"
+
+	AttributeKeyBaseFees      = "base_fees"
+	AttributeKeyBurned        = "burned"
+	AttributeKeyCommunityPool = "community_pool"
+)
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, totalBurned sdk.Coins) GenesisState {
+	return GenesisState{
+		Params:      params,
+		TotalBurned: totalBurned,
+	}
+}
+
+// DefaultGenesisState returns the default feeburn module genesis state
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:      DefaultParams(),
+		TotalBurned: sdk.Coins{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.TotalBurned.Validate(); err != nil {
+		return fmt.Errorf("invalid total burned: %w", err)
+	}
+
+	return gs.Params.Validate()
+}
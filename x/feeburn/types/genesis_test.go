@@ -0,0 +1,37 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	half := sdk.NewDecWithPrec(5, 1)
+
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expPass  bool
+	}{
+		{"default", DefaultGenesisState(), true},
+		{"burn and community pool", &GenesisState{Params: NewParams(half, half), TotalBurned: sdk.NewCoins(sdk.NewInt64Coin("aphoton", 100))}, true},
+		{"whole base fees burned", &GenesisState{Params: NewParams(sdk.OneDec(), sdk.ZeroDec())}, true},
+		{"fractions above 1", &GenesisState{Params: NewParams(half, sdk.NewDecWithPrec(6, 1))}, false},
+		{"negative fraction", &GenesisState{Params: NewParams(sdk.NewDec(-1), half)}, false},
+		{"fraction above 1", &GenesisState{Params: NewParams(sdk.ZeroDec(), sdk.NewDec(2))}, false},
+		{"nil fraction", &GenesisState{Params: Params{BurnFraction: half}}, false},
+		{"invalid total burned", &GenesisState{Params: DefaultParams(), TotalBurned: sdk.Coins{{Denom: "aphoton", Amount: sdk.NewInt(-1)}}}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+	feemarkettypes "github.com/tharsis/ethermint/x/feemarket/types"
+)
+
+// AccountKeeper defines the expected interface needed to retrieve the fee
+// collector address.
+type AccountKeeper interface {
+	GetModuleAddress(moduleName string) sdk.AccAddress
+}
+
+// BankKeeper defines the expected interface needed to burn the base fees.
+type BankKeeper interface {
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+}
+
+// DistrKeeper defines the expected distribution keeper interface used to fund
+// the community pool.
+type DistrKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// FeeMarketKeeper defines the expected fee market keeper interface used to
+// retrieve the base fee.
+type FeeMarketKeeper interface {
+	GetParams(ctx sdk.Context) feemarkettypes.Params
+	GetBaseFee(ctx sdk.Context) *big.Int
+}
+
+// EVMKeeper defines the expected EVM keeper interface used to retrieve the
+// EVM denomination.
+type EVMKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+}
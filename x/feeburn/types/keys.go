@@ -0,0 +1,39 @@
+package types
+
+// constants
+const (
+	// module name
+	ModuleName = "feeburn"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// TransientKey is the key to access the feeburn transient store, that is
+	// reset during the Commit phase.
+	TransientKey = "transient_" + ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the feeburn persistent store
+const (
+	prefixTotalBurned = iota + 1
+)
+
+// prefix bytes for the feeburn transient store
+const (
+	prefixTransientBaseFee = iota + 1
+	prefixTransientBaseFees
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixTotalBurned = []byte{prefixTotalBurned}
+)
+
+// Transient Store key prefixes
+var (
+	KeyPrefixTransientBaseFee  = []byte{prefixTransientBaseFee}
+	KeyPrefixTransientBaseFees = []byte{prefixTransientBaseFees}
+)
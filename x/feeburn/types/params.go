@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// Parameter store key
+var (
+	ParamStoreKeyBurnFraction          = []byte("BurnFraction")
+	ParamStoreKeyCommunityPoolFraction = []byte("CommunityPoolFraction")
+)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(burnFraction, communityPoolFraction sdk.Dec) Params {
+	return Params{
+		BurnFraction:          burnFraction,
+		CommunityPoolFraction: communityPoolFraction,
+	}
+}
+
+// DefaultParams returns default feeburn module parameters. The base fees are
+// distributed to the stakers by default.
+func DefaultParams() Params {
+	return Params{
+		BurnFraction:          sdk.ZeroDec(),
+		CommunityPoolFraction: sdk.ZeroDec(),
+	}
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyBurnFraction, &p.BurnFraction, validateFraction),
+		paramtypes.NewParamSetPair(ParamStoreKeyCommunityPoolFraction, &p.CommunityPoolFraction, validateFraction),
+	}
+}
+
+// Validate performs a stateless validation of the feeburn module parameters.
+func (p Params) Validate() error {
+	if err := validateFraction(p.BurnFraction); err != nil {
+		return err
+	}
+
+	if err := validateFraction(p.CommunityPoolFraction); err != nil {
+		return err
+	}
+
+	if total := p.BurnFraction.Add(p.CommunityPoolFraction); total.GT(sdk.OneDec()) {
+		return fmt.Errorf("burn and community pool fractions cannot exceed 1: %s", total)
+	}
+
+	return nil
+}
+
+// IsEnabled returns true if any fraction of the base fees is burned or sent to
+// the community pool.
+func (p Params) IsEnabled() bool {
+	return p.BurnFraction.IsPositive() || p.CommunityPoolFraction.IsPositive()
+}
+
+func validateFraction(i interface{}) error {
+	fraction, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if fraction.IsNil() {
+		return fmt.Errorf("fraction cannot be nil")
+	}
+
+	if fraction.IsNegative() || fraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("fraction must be between 0 and 1: %s", fraction)
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/tharsis/evmos/x/fees/types"
+)
+
+// GetQueryCmd returns the parent command for all fees CLI query commands
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the fees module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetFeesCmd(),
+		GetFeeCmd(),
+		GetDeployerFeesCmd(),
+		GetParamsCmd(),
+	)
+	return cmd
+}
+
+// GetFeesCmd queries all contracts registered for fee distribution
+func GetFeesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contracts",
+		Short: "Gets all contracts registered for fee distribution",
+		Long:  "Gets all contracts registered for fee distribution",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryFeesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Fees(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contracts")
+	return cmd
+}
+
+// GetFeeCmd queries the fee registration of a contract
+func GetFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract [contract_hex]",
+		Short: "Gets a contract registered for fee distribution",
+		Long:  "Gets a contract registered for fee distribution by its hex address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryFeeRequest{
+				ContractAddress: args[0],
+			}
+
+			res, err := queryClient.Fee(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetDeployerFeesCmd queries the contracts registered for fee distribution by
+// a deployer
+func GetDeployerFeesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deployer-contracts [deployer_bech32]",
+		Short: "Gets the contracts registered for fee distribution by a deployer",
+		Long:  "Gets the contracts registered for fee distribution by a deployer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDeployerFeesRequest{
+				DeployerAddress: args[0],
+				Pagination:      pageReq,
+			}
+
+			res, err := queryClient.DeployerFees(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "deployer contracts")
+	return cmd
+}
+
+// GetParamsCmd queries the module parameters
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Gets fees params",
+		Long:  "Gets fees params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryParamsRequest{}
+
+			res, err := queryClient.Params(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
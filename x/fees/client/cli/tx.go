@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/x/fees/types"
+)
+
+// NewTxCmd returns a root CLI command handler for fees transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "fees subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRegisterFeeCmd(),
+		NewUpdateFeeCmd(),
+		NewCancelFeeCmd(),
+	)
+	return txCmd
+}
+
+// NewRegisterFeeCmd returns a CLI command handler for registering a contract
+// for fee distribution
+func NewRegisterFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register [contract_hex] [nonces] [withdraw_bech32]",
+		Short: "Register a contract for fee distribution. The nonces are the comma separated nonces of the address derivation path from the sender to the contract. When the withdraw address is omitted, the fees are sent to the sender.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if !common.IsHexAddress(contract) {
+				return fmt.Errorf("invalid contract hex address %s", contract)
+			}
+
+			var nonces []uint64
+			for _, n := range strings.Split(args[1], ",") {
+				nonce, err := strconv.ParseUint(strings.TrimSpace(n), 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid nonce %s: %w", n, err)
+				}
+				nonces = append(nonces, nonce)
+			}
+
+			var withdraw string
+			if len(args) == 3 {
+				withdraw = args[2]
+			}
+
+			msg := &types.MsgRegisterFee{
+				ContractAddress: contract,
+				DeployerAddress: cliCtx.GetFromAddress().String(),
+				WithdrawAddress: withdraw,
+				Nonces:          nonces,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUpdateFeeCmd returns a CLI command handler for updating the withdraw
+// address of a registered contract
+func NewUpdateFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update [contract_hex] [withdraw_bech32]",
+		Short: "Update the withdraw address of a contract registered for fee distribution.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			withdraw, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateFee{
+				ContractAddress: args[0],
+				DeployerAddress: cliCtx.GetFromAddress().String(),
+				WithdrawAddress: withdraw.String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelFeeCmd returns a CLI command handler for canceling the fee
+// registration of a contract
+func NewCancelFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [contract_hex]",
+		Short: "Cancel the fee distribution of a registered contract.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCancelFee{
+				ContractAddress: args[0],
+				DeployerAddress: cliCtx.GetFromAddress().String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,29 @@
+package fees
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/x/fees/keeper"
+	"github.com/tharsis/evmos/x/fees/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data types.GenesisState,
+) {
+	k.SetParams(ctx, data.Params)
+
+	for _, fee := range data.Fees {
+		k.SetFee(ctx, fee)
+	}
+}
+
+// ExportGenesis export module status
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params: k.GetParams(ctx),
+		Fees:   k.GetAllFees(ctx),
+	}
+}
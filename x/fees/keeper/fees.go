@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/x/fees/types"
+)
+
+// GetAllFees returns all the contracts registered for fee distribution
+func (k Keeper) GetAllFees(ctx sdk.Context) []types.Fee {
+	fees := []types.Fee{}
+
+	k.IterateFees(ctx, func(fee types.Fee) (stop bool) {
+		fees = append(fees, fee)
+		return false
+	})
+
+	return fees
+}
+
+// IterateFees iterates over all the contracts registered for fee distribution
+// and performs a callback function
+func (k Keeper) IterateFees(ctx sdk.Context, handlerFn func(fee types.Fee) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFee)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var fee types.Fee
+		k.cdc.MustUnmarshal(iterator.Value(), &fee)
+
+		if handlerFn(fee) {
+			break
+		}
+	}
+}
+
+// GetFee returns the fee registration of the given contract
+func (k Keeper) GetFee(ctx sdk.Context, contract common.Address) (types.Fee, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFee)
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.Fee{}, false
+	}
+
+	var fee types.Fee
+	k.cdc.MustUnmarshal(bz, &fee)
+	return fee, true
+}
+
+// SetFee stores the fee registration of a contract and indexes it by its
+// deployer
+func (k Keeper) SetFee(ctx sdk.Context, fee types.Fee) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFee)
+	contract := fee.GetContractAddr()
+	bz := k.cdc.MustMarshal(&fee)
+	store.Set(contract.Bytes(), bz)
+
+	deployerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixDeployerFees(fee.GetDeployerAddr()))
+	deployerStore.Set(contract.Bytes(), []byte{1})
+}
+
+// DeleteFee removes the fee registration of a contract along with its deployer
+// index
+func (k Keeper) DeleteFee(ctx sdk.Context, fee types.Fee) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFee)
+	contract := fee.GetContractAddr()
+	store.Delete(contract.Bytes())
+
+	deployerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixDeployerFees(fee.GetDeployerAddr()))
+	deployerStore.Delete(contract.Bytes())
+}
+
+// IsFeeRegistered checks if the contract is registered for fee distribution
+func (k Keeper) IsFeeRegistered(ctx sdk.Context, contract common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFee)
+	return store.Has(contract.Bytes())
+}
+
+// GetFeesByDeployer returns the contracts registered for fee distribution by
+// the given deployer
+func (k Keeper) GetFeesByDeployer(ctx sdk.Context, deployer sdk.AccAddress) []common.Address {
+	contracts := []common.Address{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixDeployerFees(deployer))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		contracts = append(contracts, common.BytesToAddress(iterator.Key()))
+	}
+
+	return contracts
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	ethermint "github.com/tharsis/ethermint/types"
+
+	"github.com/tharsis/evmos/x/fees/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Fees returns all contracts registered for fee distribution
+func (k Keeper) Fees(c context.Context, req *types.QueryFeesRequest) (*types.QueryFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var fees []types.Fee
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFee)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var fee types.Fee
+		if err := k.cdc.Unmarshal(value, &fee); err != nil {
+			return err
+		}
+		fees = append(fees, fee)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFeesResponse{
+		Fees:       fees,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Fee returns the fee registration of a given contract
+func (k Keeper) Fee(c context.Context, req *types.QueryFeeRequest) (*types.QueryFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ethermint.ValidateAddress(req.ContractAddress); err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid format for contract %s, should be hex ('0x...')", req.ContractAddress,
+		)
+	}
+
+	fee, found := k.GetFee(ctx, common.HexToAddress(req.ContractAddress))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "fees registered contract '%s'", req.ContractAddress)
+	}
+
+	return &types.QueryFeeResponse{Fee: fee}, nil
+}
+
+// DeployerFees returns all contracts registered for fee distribution by a
+// given deployer
+func (k Keeper) DeployerFees(c context.Context, req *types.QueryDeployerFeesRequest) (*types.QueryDeployerFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	deployer, err := sdk.AccAddressFromBech32(req.DeployerAddress)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid format for deployer %s, should be bech32 ('evmos...')", req.DeployerAddress,
+		)
+	}
+
+	var fees []types.Fee
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixDeployerFees(deployer))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		fee, found := k.GetFee(ctx, common.BytesToAddress(key))
+		if found {
+			fees = append(fees, fee)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDeployerFeesResponse{
+		Fees:       fees,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Params returns the fees module parameters
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tharsis/evmos/x/fees/types"
+)
+
+// PostTxProcessing implements the EVM post transaction hook. It sends the
+// developer share of the transaction fees, paid with the EVM denomination, from
+// the fee collector to the withdraw address of the called contract.
+func (k Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	params := k.GetParams(ctx)
+	if !params.EnableFees {
+		return nil
+	}
+
+	// contract deployments don't have a registration
+	contract := msg.To()
+	if contract == nil {
+		return nil
+	}
+
+	fee, found := k.GetFee(ctx, *contract)
+	if !found {
+		return nil
+	}
+
+	txFee := new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), msg.GasPrice())
+	developerFee := sdk.NewDecFromBigInt(txFee).Mul(params.DeveloperShares).TruncateInt()
+	if !developerFee.IsPositive() {
+		return nil
+	}
+
+	evmDenom := k.evmKeeper.GetParams(ctx).EvmDenom
+	fees := sdk.Coins{sdk.NewCoin(evmDenom, developerFee)}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, k.feeCollectorName, fee.GetWithdrawAddr(), fees); err != nil {
+		return sdkerrors.Wrapf(
+			err,
+			"fee collector account failed to distribute developer fees (%s) to withdraw address %s",
+			fees, fee.WithdrawAddress,
+		)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDistributeFee,
+			sdk.NewAttribute(types.AttributeKeyContract, fee.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, fee.WithdrawAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, fees.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/tharsis/evmos/x/fees/types"
+)
+
+// Keeper of this module maintains the contracts registered for fee
+// distribution.
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        codec.BinaryCodec
+	paramstore paramtypes.Subspace
+
+	accountKeeper    types.AccountKeeper
+	bankKeeper       types.BankKeeper
+	evmKeeper        types.EVMKeeper
+	feeCollectorName string
+}
+
+// NewKeeper creates new instances of the fees Keeper
+func NewKeeper(
+	storeKey sdk.StoreKey,
+	cdc codec.BinaryCodec,
+	ps paramtypes.Subspace,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	evmKeeper types.EVMKeeper,
+	feeCollector string,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:         storeKey,
+		cdc:              cdc,
+		paramstore:       ps,
+		accountKeeper:    ak,
+		bankKeeper:       bk,
+		evmKeeper:        evmKeeper,
+		feeCollectorName: feeCollector,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/tharsis/ethermint/tests"
+	ethermint "github.com/tharsis/ethermint/types"
+
+	"github.com/tharsis/evmos/app"
+	erc20types "github.com/tharsis/evmos/x/erc20/types"
+	"github.com/tharsis/evmos/x/fees/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx      sdk.Context
+	app      *app.Evmos
+	deployer sdk.AccAddress
+	contract common.Address
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.Setup(false, nil)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: "evmos_9000-1",
+		Time:    time.Now().UTC(),
+	})
+
+	// contract deployed by a factory contract, itself deployed by the deployer
+	deployer := tests.GenerateAddress()
+	factory := crypto.CreateAddress(deployer, 3)
+	suite.deployer = deployer.Bytes()
+	suite.contract = crypto.CreateAddress(factory, 1)
+
+	acc := &ethermint.EthAccount{
+		BaseAccount: authtypes.NewBaseAccountWithAddress(suite.contract.Bytes()),
+		CodeHash:    crypto.Keccak256Hash([]byte("code")).String(),
+	}
+	suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccount(suite.ctx, acc))
+}
+
+func (suite *KeeperTestSuite) TestRegisterFee() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	withdraw := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	_, err := suite.app.FeesKeeper.RegisterFee(ctx, types.NewMsgRegisterFee(suite.contract, suite.deployer, nil, []uint64{3, 2}))
+	suite.Require().Error(err, "wrong nonces")
+
+	notDeployer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	_, err = suite.app.FeesKeeper.RegisterFee(ctx, types.NewMsgRegisterFee(suite.contract, notDeployer, nil, []uint64{3, 1}))
+	suite.Require().Error(err, "not the deployer")
+
+	notDeployed := crypto.CreateAddress(common.BytesToAddress(suite.deployer), 4)
+	_, err = suite.app.FeesKeeper.RegisterFee(ctx, types.NewMsgRegisterFee(notDeployed, suite.deployer, nil, []uint64{4}))
+	suite.Require().ErrorIs(err, types.ErrFeeContractNotDeployed)
+
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	_, err = suite.app.FeesKeeper.RegisterFee(ctx, types.NewMsgRegisterFee(suite.contract, suite.deployer, feeCollector, []uint64{3, 1}))
+	suite.Require().Error(err, "blocked withdraw address")
+
+	_, err = suite.app.FeesKeeper.RegisterFee(ctx, types.NewMsgRegisterFee(suite.contract, suite.deployer, nil, []uint64{3, 1}))
+	suite.Require().NoError(err)
+
+	fee, found := suite.app.FeesKeeper.GetFee(suite.ctx, suite.contract)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.deployer.String(), fee.WithdrawAddress)
+	suite.Require().Equal([]common.Address{suite.contract}, suite.app.FeesKeeper.GetFeesByDeployer(suite.ctx, suite.deployer))
+
+	_, err = suite.app.FeesKeeper.RegisterFee(ctx, types.NewMsgRegisterFee(suite.contract, suite.deployer, nil, []uint64{3, 1}))
+	suite.Require().ErrorIs(err, types.ErrFeeAlreadyRegistered)
+
+	_, err = suite.app.FeesKeeper.UpdateFee(ctx, types.NewMsgUpdateFee(suite.contract, notDeployer, withdraw))
+	suite.Require().ErrorIs(err, types.ErrFeeDeployerMismatch)
+
+	_, err = suite.app.FeesKeeper.UpdateFee(ctx, types.NewMsgUpdateFee(suite.contract, suite.deployer, withdraw))
+	suite.Require().NoError(err)
+	fee, _ = suite.app.FeesKeeper.GetFee(suite.ctx, suite.contract)
+	suite.Require().Equal(withdraw.String(), fee.WithdrawAddress)
+
+	_, err = suite.app.FeesKeeper.CancelFee(ctx, types.NewMsgCancelFee(suite.contract, suite.deployer))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.app.FeesKeeper.IsFeeRegistered(suite.ctx, suite.contract))
+	suite.Require().Empty(suite.app.FeesKeeper.GetFeesByDeployer(suite.ctx, suite.deployer))
+
+	_, err = suite.app.FeesKeeper.CancelFee(ctx, types.NewMsgCancelFee(suite.contract, suite.deployer))
+	suite.Require().ErrorIs(err, types.ErrFeeNotFound)
+}
+
+func (suite *KeeperTestSuite) TestPostTxProcessing() {
+	withdraw := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	_, err := suite.app.FeesKeeper.RegisterFee(
+		sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgRegisterFee(suite.contract, suite.deployer, withdraw, []uint64{3, 1}),
+	)
+	suite.Require().NoError(err)
+
+	// fund the fee collector with the transaction fees
+	denom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+	fees := sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, erc20types.ModuleName, fees))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, erc20types.ModuleName, authtypes.FeeCollectorName, fees))
+
+	sender := tests.GenerateAddress()
+	receipt := &ethtypes.Receipt{Status: ethtypes.ReceiptStatusSuccessful, GasUsed: 100}
+
+	// calls to other addresses don't distribute fees
+	other := tests.GenerateAddress()
+	msg := ethtypes.NewMessage(sender, &other, 0, big.NewInt(0), 200, big.NewInt(5), nil, nil, nil, nil, false)
+	suite.Require().NoError(suite.app.FeesKeeper.PostTxProcessing(suite.ctx, msg, receipt))
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, withdraw, denom).IsZero())
+
+	// 50% of 100 gas * 5 gas price
+	msg = ethtypes.NewMessage(sender, &suite.contract, 0, big.NewInt(0), 200, big.NewInt(5), nil, nil, nil, nil, false)
+	suite.Require().NoError(suite.app.FeesKeeper.PostTxProcessing(suite.ctx, msg, receipt))
+	suite.Require().Equal(int64(250), suite.app.BankKeeper.GetBalance(suite.ctx, withdraw, denom).Amount.Int64())
+
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	suite.Require().Equal(int64(750), suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, denom).Amount.Int64())
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ethermint "github.com/tharsis/ethermint/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/tharsis/evmos/x/fees/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// RegisterFee registers a contract to receive a share of the transaction fees
+// paid by its users. The deployer proves the contract ownership by providing
+// the nonces of the address derivation path that leads to the contract.
+func (k Keeper) RegisterFee(goCtx context.Context, msg *types.MsgRegisterFee) (*types.MsgRegisterFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).EnableFees {
+		return nil, sdkerrors.Wrap(types.ErrFeesDisabled, "registration is currently disabled by governance")
+	}
+
+	// Error checked during msg validation
+	contract := common.HexToAddress(msg.ContractAddress)
+	deployer, _ := sdk.AccAddressFromBech32(msg.DeployerAddress)
+
+	var withdraw sdk.AccAddress
+	if msg.WithdrawAddress != "" {
+		withdraw, _ = sdk.AccAddressFromBech32(msg.WithdrawAddress)
+	}
+
+	if k.IsFeeRegistered(ctx, contract) {
+		return nil, sdkerrors.Wrapf(types.ErrFeeAlreadyRegistered, "contract %s", contract)
+	}
+
+	// derive the contract address from the deployer and the nonces, where
+	// every nonce but the first one belongs to a factory contract
+	derived := common.BytesToAddress(deployer)
+	for _, nonce := range msg.Nonces {
+		derived = crypto.CreateAddress(derived, nonce)
+	}
+
+	if derived != contract {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"contract %s was not deployed by %s with nonces %v", contract, msg.DeployerAddress, msg.Nonces,
+		)
+	}
+
+	// check if the contract is deployed
+	acc := k.accountKeeper.GetAccount(ctx, contract.Bytes())
+	ethAccount, ok := acc.(*ethermint.EthAccount)
+	if !ok || ethAccount.GetCodeHash() == common.BytesToHash(evmtypes.EmptyCodeHash) {
+		return nil, sdkerrors.Wrapf(types.ErrFeeContractNotDeployed, "contract %s", contract)
+	}
+
+	fee := types.NewFee(contract, deployer, withdraw)
+	if k.bankKeeper.BlockedAddr(fee.GetWithdrawAddr()) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive fees", fee.WithdrawAddress)
+	}
+
+	k.SetFee(ctx, fee)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeRegisterFee,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.DeployerAddress),
+				sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
+				sdk.NewAttribute(types.AttributeKeyWithdrawAddress, fee.WithdrawAddress),
+			),
+		},
+	)
+
+	return &types.MsgRegisterFeeResponse{}, nil
+}
+
+// UpdateFee updates the withdraw address of a registered contract
+func (k Keeper) UpdateFee(goCtx context.Context, msg *types.MsgUpdateFee) (*types.MsgUpdateFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).EnableFees {
+		return nil, sdkerrors.Wrap(types.ErrFeesDisabled, "updates are currently disabled by governance")
+	}
+
+	fee, err := k.getDeployerFee(ctx, msg.ContractAddress, msg.DeployerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Error checked during msg validation
+	withdraw, _ := sdk.AccAddressFromBech32(msg.WithdrawAddress)
+	if k.bankKeeper.BlockedAddr(withdraw) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive fees", msg.WithdrawAddress)
+	}
+
+	fee.WithdrawAddress = withdraw.String()
+	k.SetFee(ctx, fee)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeUpdateFee,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.DeployerAddress),
+				sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
+				sdk.NewAttribute(types.AttributeKeyWithdrawAddress, fee.WithdrawAddress),
+			),
+		},
+	)
+
+	return &types.MsgUpdateFeeResponse{}, nil
+}
+
+// CancelFee removes the fee registration of a contract
+func (k Keeper) CancelFee(goCtx context.Context, msg *types.MsgCancelFee) (*types.MsgCancelFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).EnableFees {
+		return nil, sdkerrors.Wrap(types.ErrFeesDisabled, "cancellations are currently disabled by governance")
+	}
+
+	fee, err := k.getDeployerFee(ctx, msg.ContractAddress, msg.DeployerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	k.DeleteFee(ctx, fee)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCancelFee,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.DeployerAddress),
+				sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
+			),
+		},
+	)
+
+	return &types.MsgCancelFeeResponse{}, nil
+}
+
+// getDeployerFee returns the fee registration of a contract, checking that it
+// was registered by the given deployer
+func (k Keeper) getDeployerFee(ctx sdk.Context, contractAddress, deployerAddress string) (types.Fee, error) {
+	contract := common.HexToAddress(contractAddress)
+
+	fee, found := k.GetFee(ctx, contract)
+	if !found {
+		return types.Fee{}, sdkerrors.Wrapf(types.ErrFeeNotFound, "contract %s", contractAddress)
+	}
+
+	// Error checked during msg validation
+	deployer, _ := sdk.AccAddressFromBech32(deployerAddress)
+	if !deployer.Equals(fee.GetDeployerAddr()) {
+		return types.Fee{}, sdkerrors.Wrapf(
+			types.ErrFeeDeployerMismatch, "%s is not the deployer of %s", deployerAddress, contractAddress,
+		)
+	}
+
+	return fee, nil
+}
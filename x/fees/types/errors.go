@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// errors
+var (
+	ErrInternalFee            = sdkerrors.Register(ModuleName, 2, "internal fees error")
+	ErrFeesDisabled           = sdkerrors.Register(ModuleName, 3, "fees are disabled")
+	ErrFeeAlreadyRegistered   = sdkerrors.Register(ModuleName, 4, "contract is already registered for fees")
+	ErrFeeNotFound            = sdkerrors.Register(ModuleName, 5, "contract is not registered for fees")
+	ErrFeeDeployerMismatch    = sdkerrors.Register(ModuleName, 6, "deployer does not match the registered deployer")
+	ErrFeeContractNotDeployed = sdkerrors.Register(ModuleName, 7, "contract is not deployed")
+)
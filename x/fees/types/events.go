@@ -0,0 +1,12 @@
+package types
+
+// fees events
+const (
+	EventTypeRegisterFee   = "register_fee"
+	EventTypeUpdateFee     = "update_fee"
+	EventTypeCancelFee     = "cancel_fee"
+	EventTypeDistributeFee = "distribute_fee"
+
+	AttributeKeyContract        = "contract"
+	AttributeKeyWithdrawAddress = "withdraw_address"
+)
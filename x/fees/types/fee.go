@@ -0,0 +1,58 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ethermint "github.com/tharsis/ethermint/types"
+)
+
+// NewFee returns an instance of Fee. If the withdraw address is empty, the
+// deployer address is used.
+func NewFee(contract common.Address, deployer, withdraw sdk.AccAddress) Fee { // nolint: interfacer
+	if withdraw.Empty() {
+		withdraw = deployer
+	}
+
+	return Fee{
+		ContractAddress: contract.String(),
+		DeployerAddress: deployer.String(),
+		WithdrawAddress: withdraw.String(),
+	}
+}
+
+// GetContractAddr returns the contract address
+func (f Fee) GetContractAddr() common.Address {
+	return common.HexToAddress(f.ContractAddress)
+}
+
+// GetDeployerAddr returns the contract deployer address
+func (f Fee) GetDeployerAddr() sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(f.DeployerAddress)
+	return addr
+}
+
+// GetWithdrawAddr returns the address that receives the transaction fees
+func (f Fee) GetWithdrawAddr() sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(f.WithdrawAddress)
+	return addr
+}
+
+// Validate performs a stateless validation of a Fee
+func (f Fee) Validate() error {
+	if err := ethermint.ValidateAddress(f.ContractAddress); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(f.DeployerAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid deployer address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(f.WithdrawAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid withdraw address")
+	}
+
+	return nil
+}
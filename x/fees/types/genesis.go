@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, fees []Fee) GenesisState {
+	return GenesisState{
+		Params: params,
+		Fees:   fees,
+	}
+}
+
+// DefaultGenesisState returns the default fees module genesis state with no
+// registered contracts.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenContracts := make(map[string]bool)
+
+	for _, fee := range gs.Fees {
+		contract := common.HexToAddress(fee.ContractAddress).Hex()
+		if seenContracts[contract] {
+			return fmt.Errorf("contract duplicated on genesis '%s'", fee.ContractAddress)
+		}
+
+		if err := fee.Validate(); err != nil {
+			return err
+		}
+
+		seenContracts[contract] = true
+	}
+
+	return gs.Params.Validate()
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	contract := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	deployer := sdk.AccAddress(common.HexToAddress("0x01").Bytes())
+	fee := NewFee(contract, deployer, nil)
+
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expPass  bool
+	}{
+		{"default", DefaultGenesisState(), true},
+		{"valid fee", &GenesisState{Params: DefaultParams(), Fees: []Fee{fee}}, true},
+		{
+			"duplicated contract",
+			&GenesisState{Params: DefaultParams(), Fees: []Fee{fee, NewFee(contract, sdk.AccAddress(common.HexToAddress("0x02").Bytes()), nil)}},
+			false,
+		},
+		{
+			"invalid withdraw address",
+			&GenesisState{Params: DefaultParams(), Fees: []Fee{{ContractAddress: contract.String(), DeployerAddress: deployer.String()}}},
+			false,
+		},
+		{
+			"invalid developer shares",
+			&GenesisState{Params: NewParams(true, sdk.NewDec(2))},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
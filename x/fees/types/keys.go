@@ -0,0 +1,31 @@
+package types
+
+// constants
+const (
+	// module name
+	ModuleName = "fees"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the fees persistent store
+const (
+	prefixFee = iota + 1
+	prefixDeployerFees
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixFee          = []byte{prefixFee}
+	KeyPrefixDeployerFees = []byte{prefixDeployerFees}
+)
+
+// GetKeyPrefixDeployerFees returns the KVStore key prefix for the contracts
+// registered by a deployer
+func GetKeyPrefixDeployerFees(deployer []byte) []byte {
+	return append(KeyPrefixDeployerFees, deployer...)
+}
@@ -0,0 +1,171 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ethermint "github.com/tharsis/ethermint/types"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterFee{}
+	_ sdk.Msg = &MsgUpdateFee{}
+	_ sdk.Msg = &MsgCancelFee{}
+)
+
+const (
+	TypeMsgRegisterFee = "register_fee"
+	TypeMsgUpdateFee   = "update_fee"
+	TypeMsgCancelFee   = "cancel_fee"
+)
+
+// NewMsgRegisterFee creates a new instance of MsgRegisterFee
+func NewMsgRegisterFee(
+	contract common.Address,
+	deployer,
+	withdraw sdk.AccAddress,
+	nonces []uint64,
+) *MsgRegisterFee { // nolint: interfacer
+	withdrawAddress := ""
+	if !withdraw.Empty() {
+		withdrawAddress = withdraw.String()
+	}
+
+	return &MsgRegisterFee{
+		ContractAddress: contract.String(),
+		DeployerAddress: deployer.String(),
+		WithdrawAddress: withdrawAddress,
+		Nonces:          nonces,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRegisterFee) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRegisterFee) Type() string { return TypeMsgRegisterFee }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRegisterFee) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DeployerAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid deployer address")
+	}
+
+	if err := ethermint.ValidateAddress(msg.ContractAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid contract address")
+	}
+
+	if msg.WithdrawAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.WithdrawAddress); err != nil {
+			return sdkerrors.Wrap(err, "invalid withdraw address")
+		}
+	}
+
+	if len(msg.Nonces) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "nonces cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgRegisterFee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRegisterFee) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DeployerAddress)
+	if err != nil {
+		return nil
+	}
+
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgUpdateFee creates a new instance of MsgUpdateFee
+func NewMsgUpdateFee(contract common.Address, deployer, withdraw sdk.AccAddress) *MsgUpdateFee { // nolint: interfacer
+	return &MsgUpdateFee{
+		ContractAddress: contract.String(),
+		DeployerAddress: deployer.String(),
+		WithdrawAddress: withdraw.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgUpdateFee) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgUpdateFee) Type() string { return TypeMsgUpdateFee }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgUpdateFee) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DeployerAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid deployer address")
+	}
+
+	if err := ethermint.ValidateAddress(msg.ContractAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid contract address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.WithdrawAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid withdraw address")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgUpdateFee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgUpdateFee) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DeployerAddress)
+	if err != nil {
+		return nil
+	}
+
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgCancelFee creates a new instance of MsgCancelFee
+func NewMsgCancelFee(contract common.Address, deployer sdk.AccAddress) *MsgCancelFee { // nolint: interfacer
+	return &MsgCancelFee{
+		ContractAddress: contract.String(),
+		DeployerAddress: deployer.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgCancelFee) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCancelFee) Type() string { return TypeMsgCancelFee }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCancelFee) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DeployerAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid deployer address")
+	}
+
+	return ethermint.ValidateAddress(msg.ContractAddress)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgCancelFee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelFee) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DeployerAddress)
+	if err != nil {
+		return nil
+	}
+
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// Parameter store key
+var (
+	ParamStoreKeyEnableFees      = []byte("EnableFees")
+	ParamStoreKeyDeveloperShares = []byte("DeveloperShares")
+)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(enableFees bool, developerShares sdk.Dec) Params {
+	return Params{
+		EnableFees:      enableFees,
+		DeveloperShares: developerShares,
+	}
+}
+
+// DefaultParams returns default fees module parameters
+func DefaultParams() Params {
+	return Params{
+		EnableFees:      true,
+		DeveloperShares: sdk.NewDecWithPrec(50, 2),
+	}
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyEnableFees, &p.EnableFees, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyDeveloperShares, &p.DeveloperShares, validateShares),
+	}
+}
+
+// Validate performs a stateless validation of the fees module parameters.
+func (p Params) Validate() error {
+	if err := validateBool(p.EnableFees); err != nil {
+		return err
+	}
+
+	return validateShares(p.DeveloperShares)
+}
+
+func validateBool(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateShares(i interface{}) error {
+	dec, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if dec.IsNil() {
+		return fmt.Errorf("developer shares cannot be nil")
+	}
+	if dec.IsNegative() {
+		return fmt.Errorf("developer shares must be positive %s", dec)
+	}
+	if dec.GT(sdk.OneDec()) {
+		return fmt.Errorf("developer shares must be less than 100: %s", dec)
+	}
+
+	return nil
+}
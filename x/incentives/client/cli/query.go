@@ -0,0 +1,204 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/tharsis/evmos/x/incentives/types"
+)
+
+// GetQueryCmd returns the parent command for all incentives CLI query commands
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the incentives module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetIncentivesCmd(),
+		GetIncentiveCmd(),
+		GetGasMetersCmd(),
+		GetGasMeterCmd(),
+		GetParamsCmd(),
+	)
+	return cmd
+}
+
+// GetIncentivesCmd queries all registered incentives
+func GetIncentivesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "incentives",
+		Short: "Gets registered incentives",
+		Long:  "Gets registered incentives",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryIncentivesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Incentives(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "incentives")
+	return cmd
+}
+
+// GetIncentiveCmd queries a registered incentive
+func GetIncentiveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "incentive [contract-address]",
+		Short: "Get a registered incentive",
+		Long:  "Get a registered incentive by its contract address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryIncentiveRequest{
+				Contract: args[0],
+			}
+
+			res, err := queryClient.Incentive(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetGasMetersCmd queries the gas meters of an incentive
+func GetGasMetersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-meters [contract-address]",
+		Short: "Gets the gas meters of an incentive",
+		Long:  "Gets the gas spent by each participant of an incentive during the current epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryGasMetersRequest{
+				Contract:   args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.GasMeters(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "gas meters")
+	return cmd
+}
+
+// GetGasMeterCmd queries the gas meter of a participant
+func GetGasMeterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-meter [contract-address] [participant-address]",
+		Short: "Gets the gas meter of a participant",
+		Long:  "Gets the gas spent by a participant of an incentive during the current epoch",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryGasMeterRequest{
+				Contract:    args[0],
+				Participant: args[1],
+			}
+
+			res, err := queryClient.GasMeter(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetParamsCmd queries the module parameters
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Gets incentives params",
+		Long:  "Gets incentives params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryParamsRequest{}
+
+			res, err := queryClient.Params(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/tharsis/evmos/x/incentives/types"
+)
+
+// NewRegisterIncentiveProposalCmd implements the command to submit a register-incentive proposal
+func NewRegisterIncentiveProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register-incentive [contract-address] [allocation] [epochs]",
+		Args:    cobra.ExactArgs(3),
+		Short:   "Submit a proposal to register a contract incentive along with an initial deposit. The allocation is the percentage of the incentives module balance distributed each epoch, per denomination.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal register-incentive <contract_address> 0.05aevmos,0.1foo 13 --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := readProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			allocations, err := sdk.ParseDecCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			epochs, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid epochs %s: %w", args[2], err)
+			}
+
+			content := types.NewRegisterIncentiveProposal(title, description, args[0], allocations, uint32(epochs))
+
+			return submitProposal(cmd, clientCtx, content, deposit)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewCancelIncentiveProposalCmd implements the command to submit a cancel-incentive proposal
+func NewCancelIncentiveProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-incentive [contract-address]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a proposal to cancel a contract incentive along with an initial deposit.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal cancel-incentive <contract_address> --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := readProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCancelIncentiveProposal(title, description, args[0])
+
+			return submitProposal(cmd, clientCtx, content, deposit)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// addProposalFlags adds the common governance proposal flags to the command
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(govcli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(govcli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(govcli.FlagDeposit); err != nil {
+		panic(err)
+	}
+}
+
+// readProposalFlags returns the title, description and deposit of a proposal
+func readProposalFlags(cmd *cobra.Command) (string, string, sdk.Coins, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	return title, description, deposit, nil
+}
+
+// submitProposal builds and broadcasts a MsgSubmitProposal for the given content
+func submitProposal(cmd *cobra.Command, clientCtx client.Context, content govtypes.Content, deposit sdk.Coins) error {
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
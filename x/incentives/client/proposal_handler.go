@@ -0,0 +1,15 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/tharsis/evmos/x/incentives/client/cli"
+	"github.com/tharsis/evmos/x/incentives/client/rest"
+)
+
+var (
+	// RegisterIncentiveProposalHandler is the CLI and REST handler for the register incentive proposal
+	RegisterIncentiveProposalHandler = govclient.NewProposalHandler(cli.NewRegisterIncentiveProposalCmd, rest.RegisterIncentiveProposalRequestHandler)
+	// CancelIncentiveProposalHandler is the CLI and REST handler for the cancel incentive proposal
+	CancelIncentiveProposalHandler = govclient.NewProposalHandler(cli.NewCancelIncentiveProposalCmd, rest.CancelIncentiveProposalRequestHandler)
+)
@@ -0,0 +1,113 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/tharsis/evmos/x/incentives/types"
+)
+
+// RegisterIncentiveProposalRequest defines a request for a new register incentive proposal.
+type RegisterIncentiveProposalRequest struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Contract    string       `json:"contract" yaml:"contract"`
+	Allocations sdk.DecCoins `json:"allocations" yaml:"allocations"`
+	Epochs      uint32       `json:"epochs" yaml:"epochs"`
+}
+
+// CancelIncentiveProposalRequest defines a request for a cancel incentive proposal.
+type CancelIncentiveProposalRequest struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Contract    string       `json:"contract" yaml:"contract"`
+}
+
+// RegisterIncentiveProposalRequestHandler returns the REST handler for the register incentive proposal
+func RegisterIncentiveProposalRequestHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: types.ModuleName,
+		Handler:  newRegisterIncentiveProposalHandler(clientCtx),
+	}
+}
+
+// CancelIncentiveProposalRequestHandler returns the REST handler for the cancel incentive proposal
+func CancelIncentiveProposalRequestHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: types.ModuleName,
+		Handler:  newCancelIncentiveProposalHandler(clientCtx),
+	}
+}
+
+func newRegisterIncentiveProposalHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RegisterIncentiveProposalRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		if !common.IsHexAddress(req.Contract) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid contract address")
+			return
+		}
+
+		content := types.NewRegisterIncentiveProposal(req.Title, req.Description, req.Contract, req.Allocations, req.Epochs)
+		writeProposalTx(w, clientCtx, req.BaseReq, content, req.Deposit)
+	}
+}
+
+func newCancelIncentiveProposalHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CancelIncentiveProposalRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		if !common.IsHexAddress(req.Contract) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid contract address")
+			return
+		}
+
+		content := types.NewCancelIncentiveProposal(req.Title, req.Description, req.Contract)
+		writeProposalTx(w, clientCtx, req.BaseReq, content, req.Deposit)
+	}
+}
+
+// writeProposalTx validates the base request and writes the generated
+// MsgSubmitProposal transaction to the response
+func writeProposalTx(w http.ResponseWriter, clientCtx client.Context, baseReq rest.BaseReq, content govtypes.Content, deposit sdk.Coins) {
+	baseReq = baseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return
+	}
+
+	fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, fromAddr)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+
+	if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+		return
+	}
+
+	tx.WriteGeneratedTxResponse(clientCtx, w, baseReq, msg)
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/x/incentives/types"
+)
+
+// DistributeRewards transfers the allocated rewards to the participants of
+// each incentive, proportionally to the gas they spent on the contract during
+// the epoch. The gas meters are reset and the remaining epochs of each
+// incentive are decreased, removing the incentives that have finished.
+func (k Keeper) DistributeRewards(ctx sdk.Context) {
+	// snapshot the module balance so that every incentive allocation is
+	// computed from the same amount
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	balance := k.bankKeeper.GetAllBalances(ctx, moduleAddr)
+
+	for _, incentive := range k.GetAllIncentives(ctx) {
+		rewards := k.rewardParticipants(ctx, incentive, balance)
+
+		incentive.Epochs--
+		incentive.TotalGas = 0
+
+		if incentive.IsActive() {
+			k.SetIncentive(ctx, incentive)
+		} else {
+			k.DeleteIncentive(ctx, incentive)
+			k.Logger(ctx).Debug("incentive finished", "contract", incentive.Contract)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDistributeIncentive,
+				sdk.NewAttribute(types.AttributeKeyContract, incentive.Contract),
+				sdk.NewAttribute(types.AttributeKeyEpochs, strconv.FormatUint(uint64(incentive.Epochs), 10)),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+			),
+		)
+	}
+}
+
+// rewardParticipants sends the rewards of an incentive to its participants,
+// deletes their gas meters and returns the total amount distributed
+func (k Keeper) rewardParticipants(
+	ctx sdk.Context,
+	incentive types.Incentive,
+	balance sdk.Coins,
+) sdk.Coins {
+	contract := incentive.GetContractAddress()
+	gasMeters := k.GetIncentiveGasMeters(ctx, contract)
+
+	// allocated amount of each denom for the incentive
+	allocated := sdk.Coins{}
+	for _, al := range incentive.Allocations {
+		amount := al.Amount.MulInt(balance.AmountOf(al.Denom)).TruncateInt()
+		if amount.IsPositive() {
+			allocated = allocated.Add(sdk.NewCoin(al.Denom, amount))
+		}
+	}
+
+	rewards := sdk.Coins{}
+	totalGas := sdk.NewIntFromUint64(incentive.TotalGas)
+
+	for _, gm := range gasMeters {
+		k.DeleteGasMeter(ctx, gm)
+
+		if allocated.IsZero() || !totalGas.IsPositive() {
+			continue
+		}
+
+		// reward = allocated * participant gas / total gas
+		gas := sdk.NewIntFromUint64(gm.CumulativeGas)
+		coins := sdk.Coins{}
+		for _, coin := range allocated {
+			amount := coin.Amount.Mul(gas).Quo(totalGas)
+			if amount.IsPositive() {
+				coins = coins.Add(sdk.NewCoin(coin.Denom, amount))
+			}
+		}
+
+		if coins.IsZero() {
+			continue
+		}
+
+		participant := sdk.AccAddress(common.HexToAddress(gm.Participant).Bytes())
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, participant, coins); err != nil {
+			k.Logger(ctx).Error(
+				"failed to distribute incentive rewards",
+				"contract", incentive.Contract,
+				"participant", gm.Participant,
+				"error", err.Error(),
+			)
+			continue
+		}
+
+		rewards = rewards.Add(coins...)
+	}
+
+	return rewards
+}
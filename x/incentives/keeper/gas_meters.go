@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/x/incentives/types"
+)
+
+// GetAllGasMeters returns all the gas meters of every incentive
+func (k Keeper) GetAllGasMeters(ctx sdk.Context) []types.GasMeter {
+	gms := []types.GasMeter{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGasMeter)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		contract, participant := types.SplitGasMeterKey(iterator.Key())
+		gas := sdk.BigEndianToUint64(iterator.Value())
+
+		gms = append(gms, types.NewGasMeter(contract, participant, gas))
+	}
+
+	return gms
+}
+
+// GetIncentiveGasMeters returns the gas meters of the given contract incentive
+func (k Keeper) GetIncentiveGasMeters(ctx sdk.Context, contract common.Address) []types.GasMeter {
+	gms := []types.GasMeter{}
+
+	k.IterateIncentiveGasMeters(ctx, contract, func(gm types.GasMeter) (stop bool) {
+		gms = append(gms, gm)
+		return false
+	})
+
+	return gms
+}
+
+// IterateIncentiveGasMeters iterates over the gas meters of the given
+// contract incentive and performs a callback function
+func (k Keeper) IterateIncentiveGasMeters(
+	ctx sdk.Context,
+	contract common.Address,
+	handlerFn func(gm types.GasMeter) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixGasMeter, contract.Bytes()...))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		participant := common.BytesToAddress(iterator.Key())
+		gas := sdk.BigEndianToUint64(iterator.Value())
+
+		if handlerFn(types.NewGasMeter(contract, participant, gas)) {
+			break
+		}
+	}
+}
+
+// GetGasMeter returns the cumulative gas spent by a participant on the given
+// contract during the current epoch
+func (k Keeper) GetGasMeter(ctx sdk.Context, contract, participant common.Address) (uint64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGasMeter)
+	key := append(contract.Bytes(), participant.Bytes()...)
+
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// SetGasMeter stores a gas meter
+func (k Keeper) SetGasMeter(ctx sdk.Context, gm types.GasMeter) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGasMeter)
+	contract := common.HexToAddress(gm.Contract)
+	participant := common.HexToAddress(gm.Participant)
+	key := append(contract.Bytes(), participant.Bytes()...)
+
+	store.Set(key, sdk.Uint64ToBigEndian(gm.CumulativeGas))
+}
+
+// DeleteGasMeter removes a gas meter
+func (k Keeper) DeleteGasMeter(ctx sdk.Context, gm types.GasMeter) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGasMeter)
+	contract := common.HexToAddress(gm.Contract)
+	participant := common.HexToAddress(gm.Participant)
+	key := append(contract.Bytes(), participant.Bytes()...)
+
+	store.Delete(key)
+}
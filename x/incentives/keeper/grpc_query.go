@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	ethermint "github.com/tharsis/ethermint/types"
+
+	"github.com/tharsis/evmos/x/incentives/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Incentives returns all registered incentives
+func (k Keeper) Incentives(c context.Context, req *types.QueryIncentivesRequest) (*types.QueryIncentivesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var incentives []types.Incentive
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIncentive)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var incentive types.Incentive
+		if err := k.cdc.Unmarshal(value, &incentive); err != nil {
+			return err
+		}
+		incentives = append(incentives, incentive)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryIncentivesResponse{
+		Incentives: incentives,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Incentive returns the incentive of a given contract
+func (k Keeper) Incentive(c context.Context, req *types.QueryIncentiveRequest) (*types.QueryIncentiveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ethermint.ValidateAddress(req.Contract); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid format for contract %s, should be hex ('0x...')", req.Contract)
+	}
+
+	incentive, found := k.GetIncentive(ctx, common.HexToAddress(req.Contract))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "incentive with contract '%s'", req.Contract)
+	}
+
+	return &types.QueryIncentiveResponse{Incentive: incentive}, nil
+}
+
+// GasMeters returns the gas meters of the participants of a given incentive
+func (k Keeper) GasMeters(c context.Context, req *types.QueryGasMetersRequest) (*types.QueryGasMetersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ethermint.ValidateAddress(req.Contract); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid format for contract %s, should be hex ('0x...')", req.Contract)
+	}
+
+	contract := common.HexToAddress(req.Contract)
+
+	var gasMeters []types.GasMeter
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixGasMeter, contract.Bytes()...))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		participant := common.BytesToAddress(key)
+		gas := sdk.BigEndianToUint64(value)
+		gasMeters = append(gasMeters, types.NewGasMeter(contract, participant, gas))
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGasMetersResponse{
+		GasMeters:  gasMeters,
+		Pagination: pageRes,
+	}, nil
+}
+
+// GasMeter returns the gas spent by a participant on a given incentive
+func (k Keeper) GasMeter(c context.Context, req *types.QueryGasMeterRequest) (*types.QueryGasMeterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ethermint.ValidateAddress(req.Contract); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid format for contract %s, should be hex ('0x...')", req.Contract)
+	}
+
+	if err := ethermint.ValidateAddress(req.Participant); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid format for participant %s, should be hex ('0x...')", req.Participant)
+	}
+
+	gas, found := k.GetGasMeter(ctx, common.HexToAddress(req.Contract), common.HexToAddress(req.Participant))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "gas meter with contract '%s' and participant '%s'", req.Contract, req.Participant)
+	}
+
+	return &types.QueryGasMeterResponse{GasMeter: gas}, nil
+}
+
+// Params returns the incentives module parameters
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/tharsis/evmos/x/epochs/types"
+	"github.com/tharsis/evmos/x/incentives/types"
+)
+
+var _ epochstypes.EpochHooks = Keeper{}
+
+// BeforeEpochStart performs a no-op
+func (k Keeper) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {}
+
+// AfterEpochEnd distributes the incentive rewards to the contract participants
+// at the end of every incentives epoch
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ int64) {
+	params := k.GetParams(ctx)
+	if !params.EnableIncentives || epochIdentifier != params.EpochIdentifier {
+		return
+	}
+
+	k.DistributeRewards(ctx)
+}
+
+// PostTxProcessing implements the EVM post transaction hook. It adds the gas
+// used by a successful transaction to the gas meter of the sender when the
+// called contract has an active incentive.
+func (k Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	if !k.GetParams(ctx).EnableIncentives {
+		return nil
+	}
+
+	// contract deployments can't be incentivized
+	contract := msg.To()
+	if contract == nil {
+		return nil
+	}
+
+	incentive, found := k.GetIncentive(ctx, *contract)
+	if !found || !incentive.IsActive() {
+		return nil
+	}
+
+	participant := msg.From()
+	gas, _ := k.GetGasMeter(ctx, *contract, participant)
+
+	k.SetGasMeter(ctx, types.NewGasMeter(*contract, participant, gas+receipt.GasUsed))
+
+	incentive.TotalGas += receipt.GasUsed
+	k.SetIncentive(ctx, incentive)
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/x/incentives/types"
+)
+
+// GetAllIncentives returns all the registered incentives
+func (k Keeper) GetAllIncentives(ctx sdk.Context) []types.Incentive {
+	incentives := []types.Incentive{}
+
+	k.IterateIncentives(ctx, func(incentive types.Incentive) (stop bool) {
+		incentives = append(incentives, incentive)
+		return false
+	})
+
+	return incentives
+}
+
+// IterateIncentives iterates over all the registered incentives and performs
+// a callback function
+func (k Keeper) IterateIncentives(ctx sdk.Context, handlerFn func(incentive types.Incentive) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIncentive)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var incentive types.Incentive
+		k.cdc.MustUnmarshal(iterator.Value(), &incentive)
+
+		if handlerFn(incentive) {
+			break
+		}
+	}
+}
+
+// GetIncentive returns the incentive registered for the given contract
+func (k Keeper) GetIncentive(ctx sdk.Context, contract common.Address) (types.Incentive, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIncentive)
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.Incentive{}, false
+	}
+
+	var incentive types.Incentive
+	k.cdc.MustUnmarshal(bz, &incentive)
+	return incentive, true
+}
+
+// SetIncentive stores an incentive
+func (k Keeper) SetIncentive(ctx sdk.Context, incentive types.Incentive) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIncentive)
+	bz := k.cdc.MustMarshal(&incentive)
+	store.Set(incentive.GetContractAddress().Bytes(), bz)
+}
+
+// DeleteIncentive removes an incentive and all its gas meters
+func (k Keeper) DeleteIncentive(ctx sdk.Context, incentive types.Incentive) {
+	contract := incentive.GetContractAddress()
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIncentive)
+	store.Delete(contract.Bytes())
+
+	for _, gm := range k.GetIncentiveGasMeters(ctx, contract) {
+		k.DeleteGasMeter(ctx, gm)
+	}
+}
+
+// IsIncentiveRegistered checks if an incentive exists for the given contract
+func (k Keeper) IsIncentiveRegistered(ctx sdk.Context, contract common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIncentive)
+	return store.Has(contract.Bytes())
+}
@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/tharsis/ethermint/tests"
+	ethermint "github.com/tharsis/ethermint/types"
+
+	"github.com/tharsis/evmos/app"
+	erc20types "github.com/tharsis/evmos/x/erc20/types"
+	"github.com/tharsis/evmos/x/incentives/types"
+)
+
+const denom = "acoin"
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx      sdk.Context
+	app      *app.Evmos
+	contract common.Address
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.Setup(false, nil)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: "evmos_9000-1",
+		Time:    time.Now().UTC(),
+	})
+
+	// set a contract account with code
+	suite.contract = tests.GenerateAddress()
+	acc := &ethermint.EthAccount{
+		BaseAccount: authtypes.NewBaseAccountWithAddress(suite.contract.Bytes()),
+		CodeHash:    crypto.Keccak256Hash([]byte("code")).String(),
+	}
+	suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccount(suite.ctx, acc))
+
+	// mint the incentives rewards
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, erc20types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, erc20types.ModuleName, types.ModuleName, coins))
+}
+
+func (suite *KeeperTestSuite) postTx(from common.Address, to *common.Address, gasUsed uint64) {
+	msg := ethtypes.NewMessage(from, to, 0, big.NewInt(0), gasUsed, big.NewInt(1), nil, nil, nil, nil, false)
+	receipt := &ethtypes.Receipt{Status: ethtypes.ReceiptStatusSuccessful, GasUsed: gasUsed}
+	suite.Require().NoError(suite.app.IncentivesKeeper.PostTxProcessing(suite.ctx, msg, receipt))
+}
+
+func (suite *KeeperTestSuite) TestRegisterIncentive() {
+	allocations := sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom, sdk.NewDecWithPrec(5, 2)))
+
+	_, err := suite.app.IncentivesKeeper.RegisterIncentive(suite.ctx, tests.GenerateAddress(), allocations, 10)
+	suite.Require().Error(err, "address is not a contract")
+
+	_, err = suite.app.IncentivesKeeper.RegisterIncentive(
+		suite.ctx, suite.contract, sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom, sdk.NewDecWithPrec(6, 2))), 10,
+	)
+	suite.Require().ErrorIs(err, types.ErrAllocationLimit)
+
+	_, err = suite.app.IncentivesKeeper.RegisterIncentive(
+		suite.ctx, suite.contract, sdk.NewDecCoins(sdk.NewDecCoinFromDec("nocoin", sdk.NewDecWithPrec(5, 2))), 10,
+	)
+	suite.Require().Error(err, "denom without supply")
+
+	incentive, err := suite.app.IncentivesKeeper.RegisterIncentive(suite.ctx, suite.contract, allocations, 10)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.contract.String(), incentive.Contract)
+	suite.Require().True(suite.app.IncentivesKeeper.IsIncentiveRegistered(suite.ctx, suite.contract))
+
+	_, err = suite.app.IncentivesKeeper.RegisterIncentive(suite.ctx, suite.contract, allocations, 10)
+	suite.Require().ErrorIs(err, types.ErrIncentiveExists)
+
+	suite.postTx(tests.GenerateAddress(), &suite.contract, 100)
+
+	suite.Require().NoError(suite.app.IncentivesKeeper.CancelIncentive(suite.ctx, suite.contract))
+	suite.Require().False(suite.app.IncentivesKeeper.IsIncentiveRegistered(suite.ctx, suite.contract))
+	suite.Require().Empty(suite.app.IncentivesKeeper.GetAllGasMeters(suite.ctx))
+
+	suite.Require().ErrorIs(suite.app.IncentivesKeeper.CancelIncentive(suite.ctx, suite.contract), types.ErrIncentiveNotFound)
+}
+
+func (suite *KeeperTestSuite) TestDistributeRewards() {
+	allocations := sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom, sdk.NewDecWithPrec(5, 2)))
+	_, err := suite.app.IncentivesKeeper.RegisterIncentive(suite.ctx, suite.contract, allocations, 2)
+	suite.Require().NoError(err)
+
+	alice, bob := tests.GenerateAddress(), tests.GenerateAddress()
+
+	// contract deployments and calls to other contracts are not recorded
+	suite.postTx(alice, nil, 500)
+	suite.postTx(alice, &bob, 500)
+
+	suite.postTx(alice, &suite.contract, 100)
+	suite.postTx(alice, &suite.contract, 200)
+	suite.postTx(bob, &suite.contract, 100)
+
+	gas, found := suite.app.IncentivesKeeper.GetGasMeter(suite.ctx, suite.contract, alice)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(300), gas)
+
+	incentive, _ := suite.app.IncentivesKeeper.GetIncentive(suite.ctx, suite.contract)
+	suite.Require().Equal(uint64(400), incentive.TotalGas)
+
+	// the rewards are only distributed at the end of the incentives epoch
+	suite.app.IncentivesKeeper.AfterEpochEnd(suite.ctx, "week", 1)
+	suite.Require().Len(suite.app.IncentivesKeeper.GetIncentiveGasMeters(suite.ctx, suite.contract), 2)
+
+	// 5% of 1000 is allocated, split 3:1 between the participants
+	suite.app.IncentivesKeeper.AfterEpochEnd(suite.ctx, "day", 1)
+	suite.Require().Equal(int64(37), suite.app.BankKeeper.GetBalance(suite.ctx, alice.Bytes(), denom).Amount.Int64())
+	suite.Require().Equal(int64(12), suite.app.BankKeeper.GetBalance(suite.ctx, bob.Bytes(), denom).Amount.Int64())
+
+	incentive, found = suite.app.IncentivesKeeper.GetIncentive(suite.ctx, suite.contract)
+	suite.Require().True(found)
+	suite.Require().Equal(uint32(1), incentive.Epochs)
+	suite.Require().Zero(incentive.TotalGas)
+	suite.Require().Empty(suite.app.IncentivesKeeper.GetIncentiveGasMeters(suite.ctx, suite.contract))
+
+	// the incentive is removed after its last epoch
+	suite.app.IncentivesKeeper.DistributeRewards(suite.ctx)
+	suite.Require().False(suite.app.IncentivesKeeper.IsIncentiveRegistered(suite.ctx, suite.contract))
+}
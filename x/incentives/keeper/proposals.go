@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ethermint "github.com/tharsis/ethermint/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/tharsis/evmos/x/incentives/types"
+)
+
+// RegisterIncentive creates an incentive for a contract
+func (k Keeper) RegisterIncentive(
+	ctx sdk.Context,
+	contract common.Address,
+	allocations sdk.DecCoins,
+	epochs uint32,
+) (*types.Incentive, error) {
+	params := k.GetParams(ctx)
+
+	// check if the incentives are globally enabled
+	if !params.EnableIncentives {
+		return nil, sdkerrors.Wrap(types.ErrIncentiveDisabled, "registration is currently disabled by governance")
+	}
+
+	// check if the incentive is already registered
+	if k.IsIncentiveRegistered(ctx, contract) {
+		return nil, sdkerrors.Wrapf(types.ErrIncentiveExists, "incentive already registered: %s", contract)
+	}
+
+	// check if the contract is deployed
+	acc := k.accountKeeper.GetAccount(ctx, sdk.AccAddress(contract.Bytes()))
+	ethAccount, ok := acc.(*ethermint.EthAccount)
+	if !ok || ethAccount.GetCodeHash() == common.BytesToHash(evmtypes.EmptyCodeHash) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "address %s is not a deployed contract", contract)
+	}
+
+	// add the allocations of the already registered incentives
+	totalAllocations := sdk.DecCoins{}
+	k.IterateIncentives(ctx, func(incentive types.Incentive) (stop bool) {
+		totalAllocations = totalAllocations.Add(incentive.Allocations...)
+		return false
+	})
+
+	for _, al := range allocations {
+		if al.Amount.GT(params.AllocationLimit) {
+			return nil, sdkerrors.Wrapf(
+				types.ErrAllocationLimit,
+				"allocation for %s (%s) exceeds limit %s", al.Denom, al.Amount, params.AllocationLimit,
+			)
+		}
+
+		// check if the coin exists by ensuring the supply is set
+		if !k.bankKeeper.GetSupply(ctx, al.Denom).Amount.IsPositive() {
+			return nil, sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidCoins,
+				"base denomination '%s' cannot have a supply of 0", al.Denom,
+			)
+		}
+
+		// check that the sum of the allocations for the denom doesn't exceed 100%
+		total := totalAllocations.AmountOf(al.Denom).Add(al.Amount)
+		if total.GT(sdk.OneDec()) {
+			return nil, sdkerrors.Wrapf(
+				types.ErrAllocationLimit,
+				"total allocation for %s (%s) cannot exceed 100%%", al.Denom, total,
+			)
+		}
+	}
+
+	incentive := types.NewIncentive(contract, allocations, epochs)
+	incentive.StartTime = ctx.BlockTime()
+
+	k.SetIncentive(ctx, incentive)
+	return &incentive, nil
+}
+
+// CancelIncentive removes the incentive of a contract along with its gas meters
+func (k Keeper) CancelIncentive(ctx sdk.Context, contract common.Address) error {
+	incentive, found := k.GetIncentive(ctx, contract)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrIncentiveNotFound, "contract %s", contract)
+	}
+
+	k.DeleteIncentive(ctx, incentive)
+	return nil
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// errors
+var (
+	ErrInternalIncentive = sdkerrors.Register(ModuleName, 2, "internal incentives error")
+	ErrIncentiveNotFound = sdkerrors.Register(ModuleName, 3, "incentive not found")
+	ErrIncentiveExists   = sdkerrors.Register(ModuleName, 4, "incentive already exists")
+	ErrIncentiveDisabled = sdkerrors.Register(ModuleName, 5, "incentives are disabled")
+	ErrAllocationLimit   = sdkerrors.Register(ModuleName, 6, "allocation exceeds limit")
+)
@@ -0,0 +1,12 @@
+package types
+
+// incentives events
+const (
+	EventTypeRegisterIncentive   = "register_incentive"
+	EventTypeCancelIncentive     = "cancel_incentive"
+	EventTypeDistributeIncentive = "distribute_incentive"
+
+	AttributeKeyContract    = "contract"
+	AttributeKeyEpochs      = "epochs"
+	AttributeKeyParticipant = "participant"
+)
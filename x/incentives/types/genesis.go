@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, incentives []Incentive, gasMeters []GasMeter) GenesisState {
+	return GenesisState{
+		Params:     params,
+		Incentives: incentives,
+		GasMeters:  gasMeters,
+	}
+}
+
+// DefaultGenesisState returns the default incentives module genesis state
+// with no registered incentives.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenIncentives := make(map[string]bool)
+
+	for _, incentive := range gs.Incentives {
+		if seenIncentives[common.HexToAddress(incentive.Contract).Hex()] {
+			return fmt.Errorf("contract duplicated on genesis '%s'", incentive.Contract)
+		}
+
+		if err := incentive.Validate(); err != nil {
+			return err
+		}
+
+		seenIncentives[common.HexToAddress(incentive.Contract).Hex()] = true
+	}
+
+	seenGasMeters := make(map[string]bool)
+
+	for _, gasMeter := range gs.GasMeters {
+		key := common.HexToAddress(gasMeter.Contract).Hex() + common.HexToAddress(gasMeter.Participant).Hex()
+		if seenGasMeters[key] {
+			return fmt.Errorf("gas meter duplicated on genesis '%s'", key)
+		}
+
+		if err := gasMeter.Validate(); err != nil {
+			return err
+		}
+
+		if !seenIncentives[common.HexToAddress(gasMeter.Contract).Hex()] {
+			return fmt.Errorf("gas meter for contract without incentive '%s'", gasMeter.Contract)
+		}
+
+		seenGasMeters[key] = true
+	}
+
+	return gs.Params.Validate()
+}
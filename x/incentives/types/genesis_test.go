@@ -0,0 +1,68 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	contract := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	participant := common.HexToAddress("0x01")
+	allocations := sdk.NewDecCoins(sdk.NewDecCoinFromDec("acoin", sdk.NewDecWithPrec(5, 2)))
+	incentive := NewIncentive(contract, allocations, 10)
+	gasMeter := NewGasMeter(contract, participant, 100)
+
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expPass  bool
+	}{
+		{"default", DefaultGenesisState(), true},
+		{
+			"valid incentive and gas meter",
+			&GenesisState{Params: DefaultParams(), Incentives: []Incentive{incentive}, GasMeters: []GasMeter{gasMeter}},
+			true,
+		},
+		{
+			"duplicated incentive",
+			&GenesisState{Params: DefaultParams(), Incentives: []Incentive{incentive, incentive}},
+			false,
+		},
+		{
+			"incentive without epochs",
+			&GenesisState{Params: DefaultParams(), Incentives: []Incentive{NewIncentive(contract, allocations, 0)}},
+			false,
+		},
+		{
+			"incentive allocation above 100%",
+			&GenesisState{
+				Params:     DefaultParams(),
+				Incentives: []Incentive{NewIncentive(contract, sdk.NewDecCoins(sdk.NewDecCoin("acoin", sdk.NewInt(2))), 10)},
+			},
+			false,
+		},
+		{
+			"duplicated gas meter",
+			&GenesisState{Params: DefaultParams(), Incentives: []Incentive{incentive}, GasMeters: []GasMeter{gasMeter, gasMeter}},
+			false,
+		},
+		{
+			"gas meter without incentive",
+			&GenesisState{Params: DefaultParams(), GasMeters: []GasMeter{gasMeter}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ethermint "github.com/tharsis/ethermint/types"
+)
+
+// NewIncentive returns an instance of Incentive
+func NewIncentive(contract common.Address, allocations sdk.DecCoins, epochs uint32) Incentive {
+	return Incentive{
+		Contract:    contract.String(),
+		Allocations: allocations,
+		Epochs:      epochs,
+		TotalGas:    0,
+	}
+}
+
+// Validate performs a stateless validation of an Incentive
+func (i Incentive) Validate() error {
+	if err := ethermint.ValidateAddress(i.Contract); err != nil {
+		return err
+	}
+
+	if err := validateAllocations(i.Allocations); err != nil {
+		return err
+	}
+
+	if i.Epochs == 0 {
+		return fmt.Errorf("epoch cannot be 0")
+	}
+
+	return nil
+}
+
+// IsActive returns true if the Incentive has remaining Epochs
+func (i Incentive) IsActive() bool {
+	return i.Epochs > 0
+}
+
+// GetContractAddress returns the contract as a common.Address
+func (i Incentive) GetContractAddress() common.Address {
+	return common.HexToAddress(i.Contract)
+}
+
+// validateAllocations checks that the allocations are valid percentages
+func validateAllocations(allocations sdk.DecCoins) error {
+	if allocations.Empty() {
+		return fmt.Errorf("incentive allocations cannot be empty")
+	}
+
+	if err := allocations.Validate(); err != nil {
+		return fmt.Errorf("invalid incentive allocations: %w", err)
+	}
+
+	for _, al := range allocations {
+		if err := validatePercentage(al.Amount); err != nil {
+			return fmt.Errorf("invalid allocation for %s: %w", al.Denom, err)
+		}
+	}
+
+	return nil
+}
+
+// NewGasMeter returns an instance of GasMeter
+func NewGasMeter(contract, participant common.Address, cumulativeGas uint64) GasMeter {
+	return GasMeter{
+		Contract:      contract.String(),
+		Participant:   participant.String(),
+		CumulativeGas: cumulativeGas,
+	}
+}
+
+// Validate performs a stateless validation of a GasMeter
+func (gm GasMeter) Validate() error {
+	if err := ethermint.ValidateAddress(gm.Contract); err != nil {
+		return err
+	}
+
+	return ethermint.ValidateAddress(gm.Participant)
+}
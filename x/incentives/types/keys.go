@@ -0,0 +1,37 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// constants
+const (
+	// module name
+	ModuleName = "incentives"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the incentives persistent store
+const (
+	prefixIncentive = iota + 1
+	prefixGasMeter
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixIncentive = []byte{prefixIncentive}
+	KeyPrefixGasMeter  = []byte{prefixGasMeter}
+)
+
+// SplitGasMeterKey splits a gas meter key, without the prefix, into the
+// contract and participant addresses
+func SplitGasMeterKey(key []byte) (contract, participant common.Address) {
+	contract = common.BytesToAddress(key[:common.AddressLength])
+	participant = common.BytesToAddress(key[common.AddressLength:])
+	return contract, participant
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	epochstypes "github.com/tharsis/evmos/x/epochs/types"
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// Parameter store key
+var (
+	ParamStoreKeyEnableIncentives = []byte("EnableIncentives")
+	ParamStoreKeyAllocationLimit  = []byte("AllocationLimit")
+	ParamStoreKeyEpochIdentifier  = []byte("EpochIdentifier")
+)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(enableIncentives bool, allocationLimit sdk.Dec, epochIdentifier string) Params {
+	return Params{
+		EnableIncentives: enableIncentives,
+		AllocationLimit:  allocationLimit,
+		EpochIdentifier:  epochIdentifier,
+	}
+}
+
+// DefaultParams returns default incentives module parameters
+func DefaultParams() Params {
+	return Params{
+		EnableIncentives: true,
+		AllocationLimit:  sdk.NewDecWithPrec(5, 2),
+		EpochIdentifier:  epochstypes.DayEpochID,
+	}
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyEnableIncentives, &p.EnableIncentives, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyAllocationLimit, &p.AllocationLimit, validatePercentage),
+		paramtypes.NewParamSetPair(ParamStoreKeyEpochIdentifier, &p.EpochIdentifier, epochstypes.ValidateEpochIdentifierInterface),
+	}
+}
+
+// Validate performs a stateless validation of the incentives module parameters.
+func (p Params) Validate() error {
+	if err := validateBool(p.EnableIncentives); err != nil {
+		return err
+	}
+
+	if err := validatePercentage(p.AllocationLimit); err != nil {
+		return err
+	}
+
+	return epochstypes.ValidateEpochIdentifierString(p.EpochIdentifier)
+}
+
+func validateBool(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePercentage(i interface{}) error {
+	dec, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if dec.IsNil() {
+		return fmt.Errorf("percentage cannot be nil")
+	}
+	if dec.IsNegative() {
+		return fmt.Errorf("percentage must be positive %s", dec)
+	}
+	if dec.GT(sdk.OneDec()) {
+		return fmt.Errorf("percentage must be less than 100: %s", dec)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	ethermint "github.com/tharsis/ethermint/types"
+)
+
+// constants
+const (
+	ProposalTypeRegisterIncentive string = "RegisterIncentive"
+	ProposalTypeCancelIncentive   string = "CancelIncentive"
+)
+
+// Implements Proposal Interface
+var (
+	_ govtypes.Content = &RegisterIncentiveProposal{}
+	_ govtypes.Content = &CancelIncentiveProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeRegisterIncentive)
+	govtypes.RegisterProposalType(ProposalTypeCancelIncentive)
+	govtypes.RegisterProposalTypeCodec(&RegisterIncentiveProposal{}, "incentives/RegisterIncentiveProposal")
+	govtypes.RegisterProposalTypeCodec(&CancelIncentiveProposal{}, "incentives/CancelIncentiveProposal")
+}
+
+// NewRegisterIncentiveProposal returns new instance of RegisterIncentiveProposal
+func NewRegisterIncentiveProposal(
+	title, description, contract string,
+	allocations sdk.DecCoins,
+	epochs uint32,
+) govtypes.Content {
+	return &RegisterIncentiveProposal{
+		Title:       title,
+		Description: description,
+		Contract:    contract,
+		Allocations: allocations,
+		Epochs:      epochs,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*RegisterIncentiveProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*RegisterIncentiveProposal) ProposalType() string {
+	return ProposalTypeRegisterIncentive
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (rip *RegisterIncentiveProposal) ValidateBasic() error {
+	if err := ethermint.ValidateAddress(rip.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract address")
+	}
+
+	if err := validateAllocations(rip.Allocations); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	if rip.Epochs == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "epochs cannot be 0")
+	}
+
+	return govtypes.ValidateAbstract(rip)
+}
+
+// NewCancelIncentiveProposal returns new instance of CancelIncentiveProposal
+func NewCancelIncentiveProposal(title, description, contract string) govtypes.Content {
+	return &CancelIncentiveProposal{
+		Title:       title,
+		Description: description,
+		Contract:    contract,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*CancelIncentiveProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*CancelIncentiveProposal) ProposalType() string {
+	return ProposalTypeCancelIncentive
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (cip *CancelIncentiveProposal) ValidateBasic() error {
+	if err := ethermint.ValidateAddress(cip.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract address")
+	}
+
+	return govtypes.ValidateAbstract(cip)
+}
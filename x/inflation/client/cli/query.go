@@ -0,0 +1,183 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/tharsis/evmos/x/inflation/types"
+)
+
+// GetQueryCmd returns the parent command for all inflation CLI query commands
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the inflation module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetPeriodCmd(),
+		GetEpochMintProvisionCmd(),
+		GetInflationRateCmd(),
+		GetCirculatingSupplyCmd(),
+		GetParamsCmd(),
+	)
+	return cmd
+}
+
+// GetPeriodCmd queries the current inflation period
+func GetPeriodCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "period",
+		Short: "Gets the current inflation period",
+		Long:  "Gets the current inflation period",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPeriodRequest{}
+
+			res, err := queryClient.Period(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetEpochMintProvisionCmd queries the epoch mint provision of the current
+// period
+func GetEpochMintProvisionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-mint-provision",
+		Short: "Gets the epoch mint provision",
+		Long:  "Gets the amount of tokens minted at the end of each epoch of the current period",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryEpochMintProvisionRequest{}
+
+			res, err := queryClient.EpochMintProvision(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetInflationRateCmd queries the inflation rate of the current period
+func GetInflationRateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inflation-rate",
+		Short: "Gets the inflation rate",
+		Long:  "Gets the annual inflation rate of the current period, in percent",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryInflationRateRequest{}
+
+			res, err := queryClient.InflationRate(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCirculatingSupplyCmd queries the circulating supply of the mint denom
+func GetCirculatingSupplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circulating-supply",
+		Short: "Gets the circulating supply",
+		Long:  "Gets the circulating supply of the mint denomination",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCirculatingSupplyRequest{}
+
+			res, err := queryClient.CirculatingSupply(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetParamsCmd queries the module parameters
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Gets inflation params",
+		Long:  "Gets inflation params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryParamsRequest{}
+
+			res, err := queryClient.Params(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,41 @@
+package inflation
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/x/inflation/keeper"
+	"github.com/tharsis/evmos/x/inflation/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	accountKeeper types.AccountKeeper,
+	data types.GenesisState,
+) {
+	k.SetParams(ctx, data.Params)
+
+	// ensure inflation module account is set on genesis
+	if acc := accountKeeper.GetModuleAccount(ctx, types.ModuleName); acc == nil {
+		panic("the inflation module account has not been set")
+	}
+
+	k.SetPeriod(ctx, data.Period)
+	k.SetEpochIdentifier(ctx, data.EpochIdentifier)
+	k.SetEpochsPerPeriod(ctx, data.EpochsPerPeriod)
+
+	// the epoch mint provision is derived from the period
+	epochMintProvision := types.CalculateEpochMintProvision(data.Params, data.Period, data.EpochsPerPeriod)
+	k.SetEpochMintProvision(ctx, epochMintProvision)
+}
+
+// ExportGenesis export module status
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:          k.GetParams(ctx),
+		Period:          k.GetPeriod(ctx),
+		EpochIdentifier: k.GetEpochIdentifier(ctx),
+		EpochsPerPeriod: k.GetEpochsPerPeriod(ctx),
+	}
+}
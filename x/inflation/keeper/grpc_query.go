@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/x/inflation/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Period returns the current inflation period
+func (k Keeper) Period(c context.Context, _ *types.QueryPeriodRequest) (*types.QueryPeriodResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryPeriodResponse{Period: k.GetPeriod(ctx)}, nil
+}
+
+// EpochMintProvision returns the amount of tokens minted at the end of each
+// epoch of the current period
+func (k Keeper) EpochMintProvision(c context.Context, _ *types.QueryEpochMintProvisionRequest) (*types.QueryEpochMintProvisionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	epochMintProvision, _ := k.GetEpochMintProvision(ctx)
+	mintDenom := k.GetParams(ctx).MintDenom
+
+	return &types.QueryEpochMintProvisionResponse{
+		EpochMintProvision: sdk.NewDecCoinFromDec(mintDenom, epochMintProvision),
+	}, nil
+}
+
+// InflationRate returns the annual inflation rate of the current period
+func (k Keeper) InflationRate(c context.Context, _ *types.QueryInflationRateRequest) (*types.QueryInflationRateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryInflationRateResponse{InflationRate: k.GetInflationRate(ctx)}, nil
+}
+
+// CirculatingSupply returns the circulating supply of the mint denomination
+func (k Keeper) CirculatingSupply(c context.Context, _ *types.QueryCirculatingSupplyRequest) (*types.QueryCirculatingSupplyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	circulatingSupply := k.GetCirculatingSupply(ctx)
+	mintDenom := k.GetParams(ctx).MintDenom
+
+	return &types.QueryCirculatingSupplyResponse{
+		CirculatingSupply: sdk.NewDecCoinFromDec(mintDenom, circulatingSupply),
+	}, nil
+}
+
+// Params returns the inflation module parameters
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
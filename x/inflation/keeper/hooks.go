@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/tharsis/evmos/x/epochs/types"
+	"github.com/tharsis/evmos/x/inflation/types"
+)
+
+var _ epochstypes.EpochHooks = Keeper{}
+
+// BeforeEpochStart performs a no-op
+func (k Keeper) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {}
+
+// AfterEpochEnd mints and allocates the epoch mint provision at the end of
+// every inflation epoch. The mint provision is updated once all the epochs of
+// the period have ended.
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	params := k.GetParams(ctx)
+	if !params.EnableInflation || epochIdentifier != k.GetEpochIdentifier(ctx) {
+		return
+	}
+
+	epochMintProvision, found := k.GetEpochMintProvision(ctx)
+	if !found {
+		panic("the epoch mint provision has not been set")
+	}
+
+	// the provision decays to zero if there is no long term inflation
+	mintedCoin := sdk.NewCoin(params.MintDenom, epochMintProvision.TruncateInt())
+	staking, incentives, communityPool := sdk.Coins{}, sdk.Coins{}, sdk.Coins{}
+	if mintedCoin.IsPositive() {
+		var err error
+		staking, incentives, communityPool, err = k.MintAndAllocateInflation(ctx, mintedCoin)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// move to the next period once all its epochs have ended
+	epochsPerPeriod := k.GetEpochsPerPeriod(ctx)
+	if epochNumber%epochsPerPeriod == 0 {
+		period := k.GetPeriod(ctx) + 1
+		k.SetPeriod(ctx, period)
+		k.SetEpochMintProvision(ctx, types.CalculateEpochMintProvision(params, period, epochsPerPeriod))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMint,
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, strconv.FormatInt(epochNumber, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyStakingRewards, staking.String()),
+			sdk.NewAttribute(types.AttributeKeyUsageIncentives, incentives.String()),
+			sdk.NewAttribute(types.AttributeKeyCommunityPool, communityPool.String()),
+		),
+	)
+}
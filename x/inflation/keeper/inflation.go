@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	incentivestypes "github.com/tharsis/evmos/x/incentives/types"
+	"github.com/tharsis/evmos/x/inflation/types"
+)
+
+// MintAndAllocateInflation mints the given coin and allocates it to the
+// staking rewards, the usage incentives and the community pool according to
+// the inflation distribution parameter. The community pool receives the
+// remainder of the truncated allocations.
+func (k Keeper) MintAndAllocateInflation(ctx sdk.Context, coin sdk.Coin) (staking, incentives, communityPool sdk.Coins, err error) {
+	coins := sdk.NewCoins(coin)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, nil, nil, err
+	}
+
+	distribution := k.GetParams(ctx).InflationDistribution
+
+	// staking rewards are distributed to the delegators by the distribution
+	// module from the fee collector
+	staking = sdk.NewCoins(k.getProportion(coin, distribution.StakingRewards))
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, staking); err != nil {
+		return nil, nil, nil, err
+	}
+
+	incentives = sdk.NewCoins(k.getProportion(coin, distribution.UsageIncentives))
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, incentivestypes.ModuleName, incentives); err != nil {
+		return nil, nil, nil, err
+	}
+
+	communityPool = coins.Sub(staking).Sub(incentives)
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	if err := k.distrKeeper.FundCommunityPool(ctx, communityPool, moduleAddr); err != nil {
+		return nil, nil, nil, err
+	}
+
+	return staking, incentives, communityPool, nil
+}
+
+// getProportion returns the given proportion of a coin, truncated
+func (k Keeper) getProportion(coin sdk.Coin, proportion sdk.Dec) sdk.Coin {
+	return sdk.NewCoin(coin.Denom, coin.Amount.ToDec().Mul(proportion).TruncateInt())
+}
+
+// GetCirculatingSupply returns the total supply of the mint denomination
+func (k Keeper) GetCirculatingSupply(ctx sdk.Context) sdk.Dec {
+	mintDenom := k.GetParams(ctx).MintDenom
+	return k.bankKeeper.GetSupply(ctx, mintDenom).Amount.ToDec()
+}
+
+// GetInflationRate returns the annual inflation rate of the current period, in
+// percent, based on the circulating supply
+func (k Keeper) GetInflationRate(ctx sdk.Context) sdk.Dec {
+	epochMintProvision, _ := k.GetEpochMintProvision(ctx)
+	circulatingSupply := k.GetCirculatingSupply(ctx)
+	if epochMintProvision.IsZero() || circulatingSupply.IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	periodMintProvision := epochMintProvision.MulInt64(k.GetEpochsPerPeriod(ctx))
+	return periodMintProvision.Quo(circulatingSupply).MulInt64(100)
+}
@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/tharsis/evmos/app"
+	epochstypes "github.com/tharsis/evmos/x/epochs/types"
+	incentivestypes "github.com/tharsis/evmos/x/incentives/types"
+	"github.com/tharsis/evmos/x/inflation/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *app.Evmos
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.Setup(false, nil)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: "evmos_9000-1",
+		Time:    time.Now().UTC(),
+	})
+}
+
+func (suite *KeeperTestSuite) moduleBalance(moduleName string) int64 {
+	addr := suite.app.AccountKeeper.GetModuleAddress(moduleName)
+	denom := suite.app.InflationKeeper.GetParams(suite.ctx).MintDenom
+	return suite.app.BankKeeper.GetBalance(suite.ctx, addr, denom).Amount.Int64()
+}
+
+func (suite *KeeperTestSuite) TestMintAndAllocateInflation() {
+	denom := suite.app.InflationKeeper.GetParams(suite.ctx).MintDenom
+	communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(denom)
+
+	staking, incentives, _, err := suite.app.InflationKeeper.MintAndAllocateInflation(suite.ctx, sdk.NewInt64Coin(denom, 1000))
+	suite.Require().NoError(err)
+
+	// 53.33%, 33.33% and the remainder to the community pool
+	suite.Require().Equal(int64(533), staking.AmountOf(denom).Int64())
+	suite.Require().Equal(int64(333), incentives.AmountOf(denom).Int64())
+	suite.Require().Equal(int64(533), suite.moduleBalance(authtypes.FeeCollectorName))
+	suite.Require().Equal(int64(333), suite.moduleBalance(incentivestypes.ModuleName))
+	suite.Require().Equal(int64(0), suite.moduleBalance(types.ModuleName))
+
+	communityPool = suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(denom).Sub(communityPool)
+	suite.Require().Equal(int64(134), communityPool.TruncateInt64())
+}
+
+func (suite *KeeperTestSuite) TestAfterEpochEnd() {
+	params := suite.app.InflationKeeper.GetParams(suite.ctx)
+	suite.app.InflationKeeper.SetEpochsPerPeriod(suite.ctx, 2)
+	suite.app.InflationKeeper.SetEpochMintProvision(suite.ctx, types.CalculateEpochMintProvision(params, 0, 2))
+
+	provision, found := suite.app.InflationKeeper.GetEpochMintProvision(suite.ctx)
+	suite.Require().True(found)
+
+	// 300M tokens per period, split over the two epochs
+	expProvision := sdk.NewDec(150_000_000).MulInt(sdk.NewIntWithDecimal(1, 18))
+	suite.Require().Equal(expProvision, provision)
+
+	supply := suite.app.InflationKeeper.GetCirculatingSupply(suite.ctx)
+
+	// only the inflation epoch mints tokens
+	suite.app.InflationKeeper.AfterEpochEnd(suite.ctx, epochstypes.WeekEpochID, 1)
+	suite.Require().Equal(supply, suite.app.InflationKeeper.GetCirculatingSupply(suite.ctx))
+
+	suite.app.InflationKeeper.AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 1)
+	suite.Require().Equal(supply.Add(expProvision), suite.app.InflationKeeper.GetCirculatingSupply(suite.ctx))
+	suite.Require().Equal(uint64(0), suite.app.InflationKeeper.GetPeriod(suite.ctx))
+
+	// the provision is halved once the period ends
+	suite.app.InflationKeeper.AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 2)
+	suite.Require().Equal(uint64(1), suite.app.InflationKeeper.GetPeriod(suite.ctx))
+
+	provision, _ = suite.app.InflationKeeper.GetEpochMintProvision(suite.ctx)
+	suite.Require().Equal(expProvision.QuoInt64(2), provision)
+
+	// the new period mints 150M tokens for the 300M tokens already minted
+	circulatingSupply := supply.Add(expProvision.MulInt64(2))
+	expRate := expProvision.Quo(circulatingSupply).MulInt64(100)
+	suite.Require().Equal(expRate, suite.app.InflationKeeper.GetInflationRate(suite.ctx))
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/x/inflation/types"
+)
+
+// GetPeriod returns the current inflation period
+func (k Keeper) GetPeriod(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyPrefixPeriod)
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetPeriod stores the current inflation period
+func (k Keeper) SetPeriod(ctx sdk.Context, period uint64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, period)
+	ctx.KVStore(k.storeKey).Set(types.KeyPrefixPeriod, bz)
+}
+
+// GetEpochMintProvision returns the amount of tokens minted at the end of each
+// epoch of the current period
+func (k Keeper) GetEpochMintProvision(ctx sdk.Context) (sdk.Dec, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyPrefixEpochMintProvision)
+	if len(bz) == 0 {
+		return sdk.ZeroDec(), false
+	}
+
+	var provision sdk.Dec
+	if err := provision.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return provision, true
+}
+
+// SetEpochMintProvision stores the amount of tokens minted at the end of each
+// epoch of the current period
+func (k Keeper) SetEpochMintProvision(ctx sdk.Context, provision sdk.Dec) {
+	bz, err := provision.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.KeyPrefixEpochMintProvision, bz)
+}
+
+// GetEpochIdentifier returns the identifier of the epoch at the end of which
+// the tokens are minted
+func (k Keeper) GetEpochIdentifier(ctx sdk.Context) string {
+	return string(ctx.KVStore(k.storeKey).Get(types.KeyPrefixEpochIdentifier))
+}
+
+// SetEpochIdentifier stores the identifier of the epoch at the end of which
+// the tokens are minted
+func (k Keeper) SetEpochIdentifier(ctx sdk.Context, epochIdentifier string) {
+	ctx.KVStore(k.storeKey).Set(types.KeyPrefixEpochIdentifier, []byte(epochIdentifier))
+}
+
+// GetEpochsPerPeriod returns the number of epochs of an inflation period
+func (k Keeper) GetEpochsPerPeriod(ctx sdk.Context) int64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyPrefixEpochsPerPeriod)
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+// SetEpochsPerPeriod stores the number of epochs of an inflation period
+func (k Keeper) SetEpochsPerPeriod(ctx sdk.Context, epochsPerPeriod int64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(epochsPerPeriod))
+	ctx.KVStore(k.storeKey).Set(types.KeyPrefixEpochsPerPeriod, bz)
+}
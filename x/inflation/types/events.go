@@ -0,0 +1,11 @@
+package types
+
+// inflation events
+const (
+	EventTypeMint = "mint"
+
+	AttributeKeyEpochNumber     = "epoch_number"
+	AttributeKeyStakingRewards  = "staking_rewards"
+	AttributeKeyUsageIncentives = "usage_incentives"
+	AttributeKeyCommunityPool   = "community_pool"
+)
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+
+	epochstypes "github.com/tharsis/evmos/x/epochs/types"
+)
+
+// DefaultEpochsPerPeriod is the number of daily epochs of a yearly period
+const DefaultEpochsPerPeriod int64 = 365
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, period uint64, epochIdentifier string, epochsPerPeriod int64) GenesisState {
+	return GenesisState{
+		Params:          params,
+		Period:          period,
+		EpochIdentifier: epochIdentifier,
+		EpochsPerPeriod: epochsPerPeriod,
+	}
+}
+
+// DefaultGenesisState returns the default inflation module genesis state with
+// yearly periods of daily epochs.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:          DefaultParams(),
+		Period:          0,
+		EpochIdentifier: epochstypes.DayEpochID,
+		EpochsPerPeriod: DefaultEpochsPerPeriod,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := epochstypes.ValidateEpochIdentifierString(gs.EpochIdentifier); err != nil {
+		return err
+	}
+
+	if gs.EpochsPerPeriod <= 0 {
+		return fmt.Errorf("epochs per period must be positive: %d", gs.EpochsPerPeriod)
+	}
+
+	return gs.Params.Validate()
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	invalidDistribution := DefaultParams()
+	invalidDistribution.InflationDistribution.CommunityPool = sdk.NewDecWithPrec(5, 1)
+
+	invalidReduction := DefaultParams()
+	invalidReduction.ExponentialCalculation.R = sdk.NewDec(2)
+
+	invalidExponent := DefaultParams()
+	invalidExponent.DenomExponent = MaxDenomExponent + 1
+
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expPass  bool
+	}{
+		{"default", DefaultGenesisState(), true},
+		{"valid", &GenesisState{Params: DefaultParams(), Period: 3, EpochIdentifier: "week", EpochsPerPeriod: 52}, true},
+		{"blank epoch identifier", &GenesisState{Params: DefaultParams(), EpochIdentifier: "", EpochsPerPeriod: 365}, false},
+		{"zero epochs per period", &GenesisState{Params: DefaultParams(), EpochIdentifier: "day"}, false},
+		{"proportions don't add up to 1", &GenesisState{Params: invalidDistribution, EpochIdentifier: "day", EpochsPerPeriod: 365}, false},
+		{"invalid reduction factor", &GenesisState{Params: invalidReduction, EpochIdentifier: "day", EpochsPerPeriod: 365}, false},
+		{"invalid mint denom", &GenesisState{Params: NewParams("", DefaultParams().ExponentialCalculation, DefaultParams().InflationDistribution, true, 18), EpochIdentifier: "day", EpochsPerPeriod: 365}, false},
+		{"denom exponent too large", &GenesisState{Params: invalidExponent, EpochIdentifier: "day", EpochsPerPeriod: 365}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestCalculateEpochMintProvision(t *testing.T) {
+	params := DefaultParams()
+	tokens := sdk.NewIntWithDecimal(1, 18)
+
+	// 300M, 150M and 75M tokens over 3 epochs
+	require.Equal(t, sdk.NewDec(100_000_000).MulInt(tokens), CalculateEpochMintProvision(params, 0, 3))
+	require.Equal(t, sdk.NewDec(50_000_000).MulInt(tokens), CalculateEpochMintProvision(params, 1, 3))
+	require.Equal(t, sdk.NewDec(25_000_000).MulInt(tokens), CalculateEpochMintProvision(params, 2, 3))
+}
+
+func TestCalculateEpochMintProvisionDenomExponent(t *testing.T) {
+	params := DefaultParams()
+	params.DenomExponent = 6
+
+	require.Equal(t, sdk.NewDec(100_000_000).MulInt(sdk.NewIntWithDecimal(1, 6)), CalculateEpochMintProvision(params, 0, 3))
+}
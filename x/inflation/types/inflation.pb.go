@@ -0,0 +1,689 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: evmos/inflation/v1/inflation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ExponentialCalculation holds the factors of the exponential decay function
+// used to compute the annual provisions of each period:
+// f(x) = a * (1 - r) ^ x + c
+type ExponentialCalculation struct {
+	// initial value
+	A github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=a,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"a"`
+	// reduction factor
+	R github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=r,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"r"`
+	// long term inflation
+	C github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=c,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"c"`
+}
+
+func (m *ExponentialCalculation) Reset()         { *m = ExponentialCalculation{} }
+func (m *ExponentialCalculation) String() string { return proto.CompactTextString(m) }
+func (*ExponentialCalculation) ProtoMessage()    {}
+func (*ExponentialCalculation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d064cb35c3ff7df8, []int{0}
+}
+func (m *ExponentialCalculation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExponentialCalculation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExponentialCalculation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExponentialCalculation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExponentialCalculation.Merge(m, src)
+}
+func (m *ExponentialCalculation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExponentialCalculation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExponentialCalculation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExponentialCalculation proto.InternalMessageInfo
+
+// InflationDistribution defines the proportions of the minted tokens allocated
+// to each recipient
+type InflationDistribution struct {
+	// proportion of the minted tokens distributed as staking rewards
+	StakingRewards github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=staking_rewards,json=stakingRewards,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"staking_rewards"`
+	// proportion of the minted tokens sent to the usage incentives
+	UsageIncentives github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=usage_incentives,json=usageIncentives,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"usage_incentives"`
+	// proportion of the minted tokens sent to the community pool
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=community_pool,json=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool"`
+}
+
+func (m *InflationDistribution) Reset()         { *m = InflationDistribution{} }
+func (m *InflationDistribution) String() string { return proto.CompactTextString(m) }
+func (*InflationDistribution) ProtoMessage()    {}
+func (*InflationDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d064cb35c3ff7df8, []int{1}
+}
+func (m *InflationDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationDistribution.Merge(m, src)
+}
+func (m *InflationDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationDistribution proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ExponentialCalculation)(nil), "evmos.inflation.v1.ExponentialCalculation")
+	proto.RegisterType((*InflationDistribution)(nil), "evmos.inflation.v1.InflationDistribution")
+}
+
+func init() {
+	proto.RegisterFile("evmos/inflation/v1/inflation.proto", fileDescriptor_d064cb35c3ff7df8)
+}
+
+var fileDescriptor_d064cb35c3ff7df8 = []byte{
+	// 317 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x4f, 0x4b, 0xc3, 0x30,
+	0x18, 0x87, 0x9b, 0x09, 0x82, 0x01, 0x37, 0x29, 0x2a, 0xc3, 0x43, 0x26, 0x3b, 0x88, 0x08, 0x36,
+	0x0c, 0xaf, 0x9e, 0xe6, 0x3c, 0xec, 0x26, 0x03, 0x11, 0xbd, 0x8c, 0x2c, 0x8b, 0x5d, 0x58, 0x9b,
+	0xb7, 0x24, 0x69, 0xdd, 0xbe, 0x85, 0x9f, 0xc1, 0x4f, 0xb3, 0xe3, 0xf0, 0x24, 0x1e, 0x86, 0xac,
+	0x5f, 0x44, 0x9a, 0xea, 0xb6, 0x73, 0x4f, 0xf9, 0xf7, 0x3e, 0x0f, 0xf9, 0xbd, 0xbc, 0xb8, 0x2d,
+	0xb2, 0x18, 0x0c, 0x95, 0xea, 0x35, 0x62, 0x56, 0x82, 0xa2, 0x59, 0x67, 0x7b, 0x08, 0x12, 0x0d,
+	0x16, 0x7c, 0xdf, 0xd5, 0x04, 0xdb, 0xeb, 0xac, 0x73, 0x76, 0x1c, 0x42, 0x08, 0xee, 0x99, 0x16,
+	0xbb, 0xb2, 0xb2, 0xfd, 0x89, 0xf0, 0xe9, 0xfd, 0x2c, 0x01, 0x25, 0x94, 0x95, 0x2c, 0xba, 0x63,
+	0x11, 0x4f, 0x4b, 0xc6, 0xbf, 0xc5, 0x88, 0x35, 0xd1, 0x39, 0xba, 0x3c, 0xe8, 0x06, 0x8b, 0x55,
+	0xcb, 0xfb, 0x5e, 0xb5, 0x2e, 0x42, 0x69, 0x27, 0xe9, 0x28, 0xe0, 0x10, 0x53, 0x0e, 0xa6, 0xf8,
+	0x47, 0xb9, 0x5c, 0x9b, 0xf1, 0x94, 0xda, 0x79, 0x22, 0x4c, 0xd0, 0x13, 0x7c, 0x80, 0x58, 0x41,
+	0xeb, 0x66, 0xad, 0x1a, 0xad, 0x0b, 0x9a, 0x37, 0xf7, 0xaa, 0xd1, 0xbc, 0xfd, 0x51, 0xc3, 0x27,
+	0xfd, 0xff, 0xec, 0x3d, 0x69, 0xac, 0x96, 0xa3, 0xd4, 0x65, 0x7a, 0xc2, 0x0d, 0x63, 0xd9, 0x54,
+	0xaa, 0x70, 0xa8, 0xc5, 0x1b, 0xd3, 0x63, 0x53, 0x31, 0x61, 0xfd, 0x4f, 0x33, 0x28, 0x2d, 0xfe,
+	0x33, 0x3e, 0x4a, 0x0d, 0x0b, 0xc5, 0x50, 0x2a, 0x5e, 0xb4, 0x32, 0x13, 0xa6, 0x62, 0xfa, 0x86,
+	0xf3, 0xf4, 0x37, 0x1a, 0xff, 0x11, 0xd7, 0x39, 0xc4, 0x71, 0xaa, 0xa4, 0x9d, 0x0f, 0x13, 0x80,
+	0xa8, 0x62, 0x63, 0x0e, 0x37, 0x96, 0x07, 0x80, 0xa8, 0xdb, 0x5b, 0xac, 0x09, 0x5a, 0xae, 0x09,
+	0xfa, 0x59, 0x13, 0xf4, 0x9e, 0x13, 0x6f, 0x99, 0x13, 0xef, 0x2b, 0x27, 0xde, 0xcb, 0xd5, 0x8e,
+	0xd0, 0x4e, 0x98, 0x36, 0xd2, 0xd0, 0x72, 0xe8, 0x66, 0x3b, 0x63, 0xe7, 0xc4, 0xa3, 0x7d, 0x37,
+	0x46, 0x37, 0xbf, 0x03, 0x00, 0xfa, 0x0c, 0x62, 0xd8, 0x96, 0x02, 0x00, 0x00,
+}
+
+func (m *ExponentialCalculation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExponentialCalculation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExponentialCalculation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.C.Size()
+		i -= size
+		if _, err := m.C.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.R.Size()
+		i -= size
+		if _, err := m.R.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.A.Size()
+		i -= size
+		if _, err := m.A.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *InflationDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.UsageIncentives.Size()
+		i -= size
+		if _, err := m.UsageIncentives.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.StakingRewards.Size()
+		i -= size
+		if _, err := m.StakingRewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInflation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInflation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ExponentialCalculation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.A.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.R.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.C.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func (m *InflationDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StakingRewards.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.UsageIncentives.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func sovInflation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInflation(x uint64) (n int) {
+	return sovInflation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExponentialCalculation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExponentialCalculation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.A.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.R.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.C.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsageIncentives", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UsageIncentives.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipInflation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthInflation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupInflation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthInflation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthInflation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowInflation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupInflation = fmt.Errorf("proto: unexpected end of group")
+)
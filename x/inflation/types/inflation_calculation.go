@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CalculateEpochMintProvision returns the amount of tokens, in the mint
+// denomination, minted at the end of each epoch of the given period. The
+// annual provisions follow the exponential decay f(x) = a * (1 - r) ^ x + c,
+// where x is the period, and are spread evenly over the epochs of the period.
+func CalculateEpochMintProvision(params Params, period uint64, epochsPerPeriod int64) sdk.Dec {
+	x := period
+	a := params.ExponentialCalculation.A
+	r := params.ExponentialCalculation.R
+	c := params.ExponentialCalculation.C
+
+	decay := sdk.OneDec().Sub(r).Power(x)
+	periodProvision := a.Mul(decay).Add(c)
+
+	// the factors are expressed in display units of the mint denomination
+	epochProvision := periodProvision.QuoInt64(epochsPerPeriod)
+	return epochProvision.MulInt(sdk.NewIntWithDecimal(1, int(params.DenomExponent)))
+}
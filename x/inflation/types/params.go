@@ -0,0 +1,178 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	ethermint "github.com/tharsis/ethermint/types"
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// Parameter store key
+var (
+	ParamStoreKeyMintDenom              = []byte("MintDenom")
+	ParamStoreKeyExponentialCalculation = []byte("ExponentialCalculation")
+	ParamStoreKeyInflationDistribution  = []byte("InflationDistribution")
+	ParamStoreKeyEnableInflation        = []byte("EnableInflation")
+	ParamStoreKeyDenomExponent          = []byte("DenomExponent")
+)
+
+// MaxDenomExponent is the maximum exponent of the display denomination, which
+// keeps the epoch provisions within the precision of sdk.Dec.
+const MaxDenomExponent = 18
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(
+	mintDenom string,
+	exponentialCalculation ExponentialCalculation,
+	inflationDistribution InflationDistribution,
+	enableInflation bool,
+	denomExponent uint32,
+) Params {
+	return Params{
+		MintDenom:              mintDenom,
+		ExponentialCalculation: exponentialCalculation,
+		InflationDistribution:  inflationDistribution,
+		EnableInflation:        enableInflation,
+		DenomExponent:          denomExponent,
+	}
+}
+
+// DefaultParams returns default inflation module parameters. The annual
+// provisions start at 300M tokens and are halved every period.
+func DefaultParams() Params {
+	return Params{
+		MintDenom: ethermint.AttoPhoton,
+		ExponentialCalculation: ExponentialCalculation{
+			A: sdk.NewDec(300_000_000),
+			R: sdk.NewDecWithPrec(50, 2),
+			C: sdk.ZeroDec(),
+		},
+		InflationDistribution: InflationDistribution{
+			StakingRewards:  sdk.NewDecWithPrec(533333334, 9),
+			UsageIncentives: sdk.NewDecWithPrec(333333333, 9),
+			CommunityPool:   sdk.NewDecWithPrec(133333333, 9),
+		},
+		EnableInflation: true,
+		DenomExponent:   ethermint.BaseDenomUnit,
+	}
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyMintDenom, &p.MintDenom, validateMintDenom),
+		paramtypes.NewParamSetPair(ParamStoreKeyExponentialCalculation, &p.ExponentialCalculation, validateExponentialCalculation),
+		paramtypes.NewParamSetPair(ParamStoreKeyInflationDistribution, &p.InflationDistribution, validateInflationDistribution),
+		paramtypes.NewParamSetPair(ParamStoreKeyEnableInflation, &p.EnableInflation, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyDenomExponent, &p.DenomExponent, validateDenomExponent),
+	}
+}
+
+// Validate performs a stateless validation of the inflation module parameters.
+func (p Params) Validate() error {
+	if err := validateMintDenom(p.MintDenom); err != nil {
+		return err
+	}
+
+	if err := validateExponentialCalculation(p.ExponentialCalculation); err != nil {
+		return err
+	}
+
+	if err := validateInflationDistribution(p.InflationDistribution); err != nil {
+		return err
+	}
+
+	if err := validateBool(p.EnableInflation); err != nil {
+		return err
+	}
+
+	return validateDenomExponent(p.DenomExponent)
+}
+
+func validateMintDenom(i interface{}) error {
+	denom, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return sdk.ValidateDenom(denom)
+}
+
+func validateExponentialCalculation(i interface{}) error {
+	v, ok := i.(ExponentialCalculation)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.A.IsNil() || v.R.IsNil() || v.C.IsNil() {
+		return errors.New("exponential calculation factors cannot be nil")
+	}
+
+	if v.A.IsNegative() {
+		return fmt.Errorf("initial value cannot be negative: %s", v.A)
+	}
+
+	if v.R.IsNegative() || v.R.GT(sdk.OneDec()) {
+		return fmt.Errorf("reduction factor must be between 0 and 1: %s", v.R)
+	}
+
+	if v.C.IsNegative() {
+		return fmt.Errorf("long term inflation cannot be negative: %s", v.C)
+	}
+
+	return nil
+}
+
+func validateInflationDistribution(i interface{}) error {
+	v, ok := i.(InflationDistribution)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.StakingRewards.IsNil() || v.UsageIncentives.IsNil() || v.CommunityPool.IsNil() {
+		return errors.New("inflation distribution proportions cannot be nil")
+	}
+
+	if v.StakingRewards.IsNegative() || v.UsageIncentives.IsNegative() || v.CommunityPool.IsNegative() {
+		return errors.New("inflation distribution proportions cannot be negative")
+	}
+
+	total := v.StakingRewards.Add(v.UsageIncentives).Add(v.CommunityPool)
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("inflation distribution proportions must add up to 1: %s", total)
+	}
+
+	return nil
+}
+
+func validateDenomExponent(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxDenomExponent {
+		return fmt.Errorf("denom exponent cannot be greater than %d: %d", MaxDenomExponent, v)
+	}
+
+	return nil
+}
+
+func validateBool(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/tharsis/evmos/x/intertx/types"
+)
+
+// GetQueryCmd returns the parent command for all intertx CLI query commands
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the intertx module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetInterchainAccountCmd(),
+	)
+	return cmd
+}
+
+// GetInterchainAccountCmd queries the interchain account of an owner
+func GetInterchainAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "interchain-account [owner] [connection_id]",
+		Short: "Gets the interchain account of an owner",
+		Long:  "Gets the address of the interchain account of an owner on the host chain of the connection",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryInterchainAccountRequest{
+				Owner:        args[0],
+				ConnectionId: args[1],
+			}
+
+			res, err := queryClient.InterchainAccount(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
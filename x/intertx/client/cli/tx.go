@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/tharsis/evmos/x/intertx/types"
+)
+
+const flagTimeoutTimestamp = "timeout-timestamp"
+
+// NewTxCmd returns a root CLI command handler for intertx transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "intertx subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRegisterAccountCmd(),
+		NewSubmitTxCmd(),
+	)
+	return txCmd
+}
+
+// NewRegisterAccountCmd returns a CLI command handler for registering an
+// interchain account
+func NewRegisterAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register [connection_id]",
+		Short: "Register an interchain account for the sender on the host chain of the connection",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterAccount(cliCtx.GetFromAddress(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSubmitTxCmd returns a CLI command handler for executing messages with an
+// interchain account
+func NewSubmitTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit [connection_id] [msgs_file]",
+		Short: "Execute messages on the host chain of the connection with the interchain account of the sender",
+		Long: `Execute messages on the host chain of the connection with the interchain account of the sender.
+The file contains a JSON array of messages, whose signer must be the interchain account address.`,
+		Example: fmt.Sprintf(`$ %s tx %s submit connection-0 msgs.json --from=<key_or_address>
+
+Where msgs.json contains:
+
+[
+  {
+    "@type": "/cosmos.staking.v1beta1.MsgDelegate",
+    "delegator_address": "cosmos1...",
+    "validator_address": "cosmosvaloper1...",
+    "amount": {"denom": "uatom", "amount": "1000"}
+  }
+]`, version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := ioutil.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var rawMsgs []json.RawMessage
+			if err := json.Unmarshal(bz, &rawMsgs); err != nil {
+				return fmt.Errorf("failed to parse messages file %s: %w", args[1], err)
+			}
+
+			msgs := make([]sdk.Msg, len(rawMsgs))
+			for i, rawMsg := range rawMsgs {
+				if err := cliCtx.Codec.UnmarshalInterfaceJSON(rawMsg, &msgs[i]); err != nil {
+					return fmt.Errorf("failed to parse message %d: %w", i, err)
+				}
+			}
+
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgSubmitTx(cliCtx.GetFromAddress(), args[0], msgs, timeoutTimestamp)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagTimeoutTimestamp, 0, "Absolute timeout of the packet in unix nanoseconds, defaults to ten minutes after the block time")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
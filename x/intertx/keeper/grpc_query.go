@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+
+	"github.com/tharsis/evmos/x/intertx/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// InterchainAccount returns the address of the interchain account of an owner
+// on the host chain of a connection.
+func (k Keeper) InterchainAccount(c context.Context, req *types.QueryInterchainAccountRequest) (*types.QueryInterchainAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	portID, err := icatypes.NewControllerPortID(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	addr, found := k.icaControllerKeeper.GetInterchainAccountAddress(ctx, req.ConnectionId, portID)
+	if !found {
+		return nil, status.Errorf(
+			codes.NotFound, "no interchain account for owner %s on connection %s", req.Owner, req.ConnectionId,
+		)
+	}
+
+	return &types.QueryInterchainAccountResponse{InterchainAccountAddress: addr}, nil
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/keeper"
+
+	"github.com/tharsis/evmos/x/intertx/types"
+)
+
+// Keeper of this module authenticates the owners of the interchain accounts
+// controlled by Evmos and sends their transactions through the ICS-27
+// controller.
+type Keeper struct {
+	cdc                 codec.BinaryCodec
+	icaControllerKeeper icacontrollerkeeper.Keeper
+	scopedKeeper        capabilitykeeper.ScopedKeeper
+}
+
+// NewKeeper creates new instances of the intertx Keeper
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	icaControllerKeeper icacontrollerkeeper.Keeper,
+	scopedKeeper capabilitykeeper.ScopedKeeper,
+) Keeper {
+	return Keeper{
+		cdc:                 cdc,
+		icaControllerKeeper: icaControllerKeeper,
+		scopedKeeper:        scopedKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// ClaimCapability claims the channel capability passed via the
+// OnChanOpenInit callback of the ICS-27 controller.
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
+}
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+
+	"github.com/tharsis/ethermint/tests"
+
+	"github.com/tharsis/evmos/app"
+	"github.com/tharsis/evmos/x/intertx"
+	"github.com/tharsis/evmos/x/intertx/types"
+)
+
+const connectionID = "connection-0"
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx   sdk.Context
+	app   *app.Evmos
+	owner sdk.AccAddress
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.Setup(false, nil)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: "evmos_9000-1",
+		Time:    time.Now().UTC(),
+	})
+	suite.owner = sdk.AccAddress(tests.GenerateAddress().Bytes())
+}
+
+func (suite *KeeperTestSuite) newMsgSubmitTx() *types.MsgSubmitTx {
+	send := banktypes.NewMsgSend(suite.owner, suite.owner, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	msg, err := types.NewMsgSubmitTx(suite.owner, connectionID, []sdk.Msg{send}, 0)
+	suite.Require().NoError(err)
+	return msg
+}
+
+func (suite *KeeperTestSuite) TestValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     sdk.Msg
+		expPass bool
+	}{
+		{"register account", types.NewMsgRegisterAccount(suite.owner, connectionID), true},
+		{"register account, invalid owner", &types.MsgRegisterAccount{Owner: "owner", ConnectionId: connectionID}, false},
+		{"register account, invalid connection", types.NewMsgRegisterAccount(suite.owner, "c"), false},
+		{"submit tx", suite.newMsgSubmitTx(), true},
+		{"submit tx, invalid owner", &types.MsgSubmitTx{Owner: "owner", ConnectionId: connectionID, Msgs: suite.newMsgSubmitTx().Msgs}, false},
+		{"submit tx, no messages", &types.MsgSubmitTx{Owner: suite.owner.String(), ConnectionId: connectionID}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+
+	sdkMsgs, err := suite.newMsgSubmitTx().GetSDKMsgs()
+	suite.Require().NoError(err)
+	suite.Require().Len(sdkMsgs, 1)
+}
+
+func (suite *KeeperTestSuite) TestRegisterAccount() {
+	// the connection does not exist
+	_, err := suite.app.InterTxKeeper.RegisterAccount(sdk.WrapSDKContext(suite.ctx), types.NewMsgRegisterAccount(suite.owner, connectionID))
+	suite.Require().ErrorIs(err, connectiontypes.ErrConnectionNotFound)
+}
+
+func (suite *KeeperTestSuite) TestSubmitTx() {
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	portID, err := icatypes.NewControllerPortID(suite.owner.String())
+	suite.Require().NoError(err)
+	channelID := channeltypes.FormatChannelIdentifier(0)
+
+	// no interchain account
+	_, err = suite.app.InterTxKeeper.SubmitTx(goCtx, suite.newMsgSubmitTx())
+	suite.Require().ErrorIs(err, types.ErrInterchainAccountNotFound)
+
+	// the channel capability is not owned by the module
+	suite.app.ICAControllerKeeper.SetActiveChannelID(suite.ctx, connectionID, portID, channelID)
+	_, err = suite.app.InterTxKeeper.SubmitTx(goCtx, suite.newMsgSubmitTx())
+	suite.Require().ErrorIs(err, types.ErrChannelCapabilityNotFound)
+
+	// the capability is claimed when the channel is opened, and the packet is
+	// then sent by the controller, which fails as the channel is not open
+	chanCap, err := suite.app.ScopedICAControllerKeeper.NewCapability(suite.ctx, host.ChannelCapabilityPath(portID, channelID))
+	suite.Require().NoError(err)
+	err = intertx.NewIBCModule(suite.app.InterTxKeeper).OnChanOpenInit(
+		suite.ctx, channeltypes.ORDERED, []string{connectionID}, portID, channelID, chanCap, channeltypes.Counterparty{}, "",
+	)
+	suite.Require().NoError(err)
+	_, err = suite.app.InterTxKeeper.SubmitTx(goCtx, suite.newMsgSubmitTx())
+	suite.Require().ErrorIs(err, icatypes.ErrActiveChannelNotFound)
+}
+
+func (suite *KeeperTestSuite) TestInterchainAccount() {
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	req := &types.QueryInterchainAccountRequest{Owner: suite.owner.String(), ConnectionId: connectionID}
+
+	_, err := suite.app.InterTxKeeper.InterchainAccount(goCtx, nil)
+	suite.Require().Error(err)
+
+	_, err = suite.app.InterTxKeeper.InterchainAccount(goCtx, req)
+	suite.Require().Error(err)
+
+	portID, err := icatypes.NewControllerPortID(suite.owner.String())
+	suite.Require().NoError(err)
+	suite.app.ICAControllerKeeper.SetInterchainAccountAddress(suite.ctx, connectionID, portID, "cosmos1host")
+
+	res, err := suite.app.InterTxKeeper.InterchainAccount(goCtx, req)
+	suite.Require().NoError(err)
+	suite.Require().Equal("cosmos1host", res.InterchainAccountAddress)
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+
+	"github.com/tharsis/evmos/x/intertx/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// RegisterAccount registers an interchain account for the owner by opening an
+// ICS-27 channel with the host chain of the connection. The account address
+// is known once the channel handshake completes.
+func (k Keeper) RegisterAccount(goCtx context.Context, msg *types.MsgRegisterAccount) (*types.MsgRegisterAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.icaControllerKeeper.RegisterInterchainAccount(ctx, msg.ConnectionId, msg.Owner); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterAccountResponse{}, nil
+}
+
+// SubmitTx sends the messages to the host chain of the connection, which
+// executes them with the interchain account of the owner.
+func (k Keeper) SubmitTx(goCtx context.Context, msg *types.MsgSubmitTx) (*types.MsgSubmitTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	channelID, found := k.icaControllerKeeper.GetActiveChannelID(ctx, msg.ConnectionId, portID)
+	if !found {
+		return nil, sdkerrors.Wrapf(
+			types.ErrInterchainAccountNotFound,
+			"no active channel for owner %s on connection %s", msg.Owner, msg.ConnectionId,
+		)
+	}
+
+	chanCap, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrChannelCapabilityNotFound, "port %s, channel %s", portID, channelID)
+	}
+
+	msgs, err := msg.GetSDKMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := icatypes.SerializeCosmosTx(k.cdc, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	timeoutTimestamp := msg.TimeoutTimestamp
+	if timeoutTimestamp == 0 {
+		timeoutTimestamp = uint64(ctx.BlockTime().Add(types.DefaultRelativePacketTimeout).UnixNano())
+	}
+
+	sequence, err := k.icaControllerKeeper.SendTx(ctx, chanCap, msg.ConnectionId, portID, packetData, timeoutTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitTxResponse{Sequence: sequence}, nil
+}
@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// errors
+var (
+	ErrChannelCapabilityNotFound = sdkerrors.Register(ModuleName, 2, "channel capability not found")
+	ErrInterchainAccountNotFound = sdkerrors.Register(ModuleName, 3, "interchain account not found")
+)
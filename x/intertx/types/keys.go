@@ -0,0 +1,16 @@
+package types
+
+import "time"
+
+// constants
+const (
+	// module name
+	ModuleName = "intertx"
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+
+	// DefaultRelativePacketTimeout is the timeout of the ICS-27 packets sent
+	// without an explicit timeout, relative to the block time
+	DefaultRelativePacketTimeout = 10 * time.Minute
+)
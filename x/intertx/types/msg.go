@@ -0,0 +1,148 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+var (
+	_ sdk.Msg                            = &MsgRegisterAccount{}
+	_ sdk.Msg                            = &MsgSubmitTx{}
+	_ codectypes.UnpackInterfacesMessage = MsgSubmitTx{}
+)
+
+const (
+	TypeMsgRegisterAccount = "register_account"
+	TypeMsgSubmitTx        = "submit_tx"
+)
+
+// NewMsgRegisterAccount creates a new instance of MsgRegisterAccount
+func NewMsgRegisterAccount(owner sdk.AccAddress, connectionID string) *MsgRegisterAccount { // nolint: interfacer
+	return &MsgRegisterAccount{
+		Owner:        owner.String(),
+		ConnectionId: connectionID,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRegisterAccount) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRegisterAccount) Type() string { return TypeMsgRegisterAccount }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRegisterAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrap(err, "invalid owner address")
+	}
+
+	return host.ConnectionIdentifierValidator(msg.ConnectionId)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgRegisterAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRegisterAccount) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil
+	}
+
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSubmitTx creates a new instance of MsgSubmitTx
+func NewMsgSubmitTx(
+	owner sdk.AccAddress,
+	connectionID string,
+	msgs []sdk.Msg,
+	timeoutTimestamp uint64,
+) (*MsgSubmitTx, error) { // nolint: interfacer
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+
+		anys[i] = any
+	}
+
+	return &MsgSubmitTx{
+		Owner:            owner.String(),
+		ConnectionId:     connectionID,
+		Msgs:             anys,
+		TimeoutTimestamp: timeoutTimestamp,
+	}, nil
+}
+
+// Route should return the name of the module
+func (msg MsgSubmitTx) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSubmitTx) Type() string { return TypeMsgSubmitTx }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSubmitTx) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrap(err, "invalid owner address")
+	}
+
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return err
+	}
+
+	if len(msg.Msgs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "messages cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSubmitTx) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSubmitTx) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil
+	}
+
+	return []sdk.AccAddress{addr}
+}
+
+// GetSDKMsgs returns the messages executed by the interchain account
+func (msg MsgSubmitTx) GetSDKMsgs() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(msg.Msgs))
+	for i, any := range msg.Msgs {
+		m, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot unpack message %s", any.TypeUrl)
+		}
+
+		msgs[i] = m
+	}
+
+	return msgs, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgSubmitTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range msg.Msgs {
+		var m sdk.Msg
+		if err := unpacker.UnpackAny(any, &m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
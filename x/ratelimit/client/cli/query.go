@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/tharsis/evmos/x/ratelimit/types"
+)
+
+// GetQueryCmd returns the parent command for all ratelimit CLI query commands
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the ratelimit module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetFlowsCmd(),
+		GetFlowCmd(),
+		GetParamsCmd(),
+	)
+	return cmd
+}
+
+// GetFlowsCmd queries the flows of all the channels and denominations
+func GetFlowsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "flows",
+		Short: "Gets the flows of the rate limited transfers",
+		Long:  "Gets the amounts received and sent during the current window of each rate limited channel and denomination",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryFlowsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Flows(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "flows")
+	return cmd
+}
+
+// GetFlowCmd queries the flow of a channel and denomination
+func GetFlowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "flow [channel] [denom]",
+		Short: "Gets the flow of a channel and denomination",
+		Long:  "Gets the amounts of a denomination received and sent over a channel during the current window",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryFlowRequest{
+				Channel: args[0],
+				Denom:   args[1],
+			}
+
+			res, err := queryClient.Flow(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetParamsCmd queries the module parameters
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Gets ratelimit params",
+		Long:  "Gets ratelimit params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryParamsRequest{}
+
+			res, err := queryClient.Params(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,34 @@
+package ratelimit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/x/ratelimit/keeper"
+	"github.com/tharsis/evmos/x/ratelimit/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data types.GenesisState,
+) {
+	k.SetParams(ctx, data.Params)
+
+	for _, flow := range data.Flows {
+		k.SetFlow(ctx, flow)
+	}
+
+	for _, sp := range data.SentPackets {
+		k.SetSentPacket(ctx, sp)
+	}
+}
+
+// ExportGenesis export module status
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:      k.GetParams(ctx),
+		Flows:       k.GetAllFlows(ctx),
+		SentPackets: k.GetAllSentPackets(ctx),
+	}
+}
@@ -0,0 +1,76 @@
+package ratelimit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+
+	"github.com/tharsis/evmos/x/ratelimit/keeper"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware implements the ICS26 callbacks for the transfer middleware
+// given the ratelimit keeper and the underlying application. The sent
+// transfers are tracked by the keeper, which wraps the channel keeper of the
+// transfer application.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper and the
+// underlying application
+func NewIBCMiddleware(k keeper.Keeper, app porttypes.IBCModule) IBCMiddleware {
+	return IBCMiddleware{
+		IBCModule: app,
+		keeper:    k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface. It receives the tokens
+// through the underlying ICS-20 application and then returns an error
+// acknowledgement if the transfer exceeds the inflow rate limit, so that the
+// transfer is refunded to the sender on the source chain.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	ack := im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+
+	return im.keeper.OnRecvPacket(ctx, packet, ack)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. It reverts the
+// outflow of the transfers refunded by the underlying ICS-20 application.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.OnAcknowledgementPacket(ctx, packet, acknowledgement)
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface. It reverts the outflow
+// of the transfers refunded by the underlying ICS-20 application.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.IBCModule.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.OnTimeoutPacket(ctx, packet)
+	return nil
+}
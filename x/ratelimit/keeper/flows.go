@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tharsis/evmos/x/ratelimit/types"
+)
+
+// GetAllFlows returns the flows of all the channels and denominations
+func (k Keeper) GetAllFlows(ctx sdk.Context) []types.Flow {
+	flows := []types.Flow{}
+
+	k.IterateFlows(ctx, func(flow types.Flow) (stop bool) {
+		flows = append(flows, flow)
+		return false
+	})
+
+	return flows
+}
+
+// IterateFlows iterates over all the stored flows and performs a callback
+// function
+func (k Keeper) IterateFlows(ctx sdk.Context, handlerFn func(flow types.Flow) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFlow)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var flow types.Flow
+		k.cdc.MustUnmarshal(iterator.Value(), &flow)
+
+		if handlerFn(flow) {
+			break
+		}
+	}
+}
+
+// GetFlow returns the flow of the given channel and denomination
+func (k Keeper) GetFlow(ctx sdk.Context, channel, denom string) (types.Flow, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFlow)
+	bz := store.Get(types.GetFlowKey(channel, denom))
+	if len(bz) == 0 {
+		return types.Flow{}, false
+	}
+
+	var flow types.Flow
+	k.cdc.MustUnmarshal(bz, &flow)
+	return flow, true
+}
+
+// SetFlow stores a flow
+func (k Keeper) SetFlow(ctx sdk.Context, flow types.Flow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFlow)
+	bz := k.cdc.MustMarshal(&flow)
+	store.Set(types.GetFlowKey(flow.Channel, flow.Denom), bz)
+}
+
+// getWindowFlow returns the flow of the current window of the given rate
+// limit. The counters are reset once the window has elapsed since its start.
+func (k Keeper) getWindowFlow(ctx sdk.Context, rl types.RateLimit) types.Flow {
+	flow, found := k.GetFlow(ctx, rl.Channel, rl.Denom)
+	if !found || !ctx.BlockTime().Before(flow.WindowStart.Add(rl.Window)) {
+		return types.NewFlow(rl.Channel, rl.Denom, ctx.BlockTime())
+	}
+
+	return flow
+}
+
+// AddInflow adds the given amount to the coins received over the channel
+// during the current window. It returns an error if the net inflow exceeds
+// the rate limit of the channel and denomination.
+func (k Keeper) AddInflow(ctx sdk.Context, channel, denom string, amount sdk.Int) error {
+	_, _, err := k.addFlow(ctx, channel, denom, amount, true)
+	return err
+}
+
+// AddOutflow adds the given amount to the coins sent over the channel during
+// the current window and records the window of the sent packet, so that its
+// outflow can only be reverted during the same window. It returns an error if
+// the net outflow exceeds the rate limit of the channel and denomination.
+func (k Keeper) AddOutflow(ctx sdk.Context, channel string, sequence uint64, denom string, amount sdk.Int) error {
+	flow, limited, err := k.addFlow(ctx, channel, denom, amount, false)
+	if err != nil || !limited {
+		return err
+	}
+
+	k.SetSentPacket(ctx, types.NewSentPacket(channel, sequence, flow.WindowStart))
+	return nil
+}
+
+// addFlow adds the given amount to the flow of the current window. It returns
+// the updated flow and false if the transfers of the channel and denomination
+// are not rate limited.
+func (k Keeper) addFlow(ctx sdk.Context, channel, denom string, amount sdk.Int, inflow bool) (types.Flow, bool, error) {
+	params := k.GetParams(ctx)
+	if !params.EnableRateLimits {
+		return types.Flow{}, false, nil
+	}
+
+	rl, found := params.GetRateLimit(channel, denom)
+	if !found {
+		return types.Flow{}, false, nil
+	}
+
+	flow := k.getWindowFlow(ctx, rl)
+
+	var (
+		net, max  sdk.Int
+		direction string
+	)
+
+	if inflow {
+		flow.Inflow = flow.Inflow.Add(amount)
+		net, max, direction = flow.NetInflow(), rl.MaxInflow, types.AttributeValueInflow
+	} else {
+		flow.Outflow = flow.Outflow.Add(amount)
+		net, max, direction = flow.NetOutflow(), rl.MaxOutflow, types.AttributeValueOutflow
+	}
+
+	if net.GT(max) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRateLimitExceeded,
+				sdk.NewAttribute(types.AttributeKeyChannel, channel),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(denom, amount).String()),
+				sdk.NewAttribute(types.AttributeKeyDirection, direction),
+			),
+		)
+
+		return types.Flow{}, true, sdkerrors.Wrapf(
+			types.ErrRateLimitExceeded,
+			"net %s of %s on %s would be %s, above the maximum of %s",
+			direction, denom, channel, net, max,
+		)
+	}
+
+	k.SetFlow(ctx, flow)
+	return flow, true, nil
+}
+
+// RevertOutflow subtracts the given amount from the coins sent over the
+// channel, once the sent packet of the given sequence has been refunded. The
+// outflow is only reverted if the packet was sent during the current window,
+// as the transfers of the previous windows no longer count towards the rate
+// limit.
+func (k Keeper) RevertOutflow(ctx sdk.Context, channel string, sequence uint64, denom string, amount sdk.Int) {
+	sentPacket, found := k.GetSentPacket(ctx, channel, sequence)
+	if !found {
+		return
+	}
+
+	k.DeleteSentPacket(ctx, channel, sequence)
+
+	flow, found := k.GetFlow(ctx, channel, denom)
+	if !found || !flow.WindowStart.Equal(sentPacket.WindowStart) {
+		return
+	}
+
+	flow.Outflow = flow.Outflow.Sub(amount)
+	k.SetFlow(ctx, flow)
+}
+
+// GetAllSentPackets returns the sent packets of all the channels that are not
+// acknowledged yet
+func (k Keeper) GetAllSentPackets(ctx sdk.Context) []types.SentPacket {
+	sentPackets := []types.SentPacket{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSentPacket)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var sentPacket types.SentPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &sentPacket)
+		sentPackets = append(sentPackets, sentPacket)
+	}
+
+	return sentPackets
+}
+
+// GetSentPacket returns the sent packet of the given channel and sequence
+func (k Keeper) GetSentPacket(ctx sdk.Context, channel string, sequence uint64) (types.SentPacket, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSentPacket)
+	bz := store.Get(types.GetSentPacketKey(channel, sequence))
+	if len(bz) == 0 {
+		return types.SentPacket{}, false
+	}
+
+	var sentPacket types.SentPacket
+	k.cdc.MustUnmarshal(bz, &sentPacket)
+	return sentPacket, true
+}
+
+// SetSentPacket stores a sent packet
+func (k Keeper) SetSentPacket(ctx sdk.Context, sentPacket types.SentPacket) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSentPacket)
+	bz := k.cdc.MustMarshal(&sentPacket)
+	store.Set(types.GetSentPacketKey(sentPacket.Channel, sentPacket.Sequence), bz)
+}
+
+// DeleteSentPacket removes the sent packet of the given channel and sequence
+func (k Keeper) DeleteSentPacket(ctx sdk.Context, channel string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSentPacket)
+	store.Delete(types.GetSentPacketKey(channel, sequence))
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/tharsis/evmos/x/ratelimit/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Flows returns the flows of all the channels and denominations
+func (k Keeper) Flows(c context.Context, req *types.QueryFlowsRequest) (*types.QueryFlowsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var flows []types.Flow
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFlow)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var flow types.Flow
+		if err := k.cdc.Unmarshal(value, &flow); err != nil {
+			return err
+		}
+		flows = append(flows, flow)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFlowsResponse{
+		Flows:      flows,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Flow returns the flow of a given channel and denomination
+func (k Keeper) Flow(c context.Context, req *types.QueryFlowRequest) (*types.QueryFlowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	flow, found := k.GetFlow(ctx, req.Channel, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "flow of denom '%s' on channel '%s'", req.Denom, req.Channel)
+	}
+
+	return &types.QueryFlowResponse{Flow: flow}, nil
+}
+
+// Params returns the ratelimit module parameters
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
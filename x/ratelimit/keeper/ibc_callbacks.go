@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+
+	"github.com/tharsis/evmos/ibc"
+)
+
+// OnRecvPacket adds the received ICS-20 transfer to the inflow of the
+// destination channel. It returns an error acknowledgement if the transfer
+// exceeds the rate limit, which discards the received tokens and refunds the
+// sender on the source chain.
+func (k Keeper) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ack exported.Acknowledgement,
+) exported.Acknowledgement {
+	// the transfer failed, so no tokens have been received
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return ack
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return ack
+	}
+
+	denom := ibc.GetReceivedDenom(packet, data)
+	if err := k.AddInflow(ctx, packet.GetDestChannel(), denom, amount); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err.Error())
+	}
+
+	return ack
+}
+
+// OnAcknowledgementPacket reverts the outflow of a sent ICS-20 transfer that
+// failed on the destination chain, as the tokens are refunded to the sender.
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+) {
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return
+	}
+
+	if ack.Success() {
+		k.DeleteSentPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+		return
+	}
+
+	k.revertSentPacket(ctx, packet)
+}
+
+// OnTimeoutPacket reverts the outflow of a sent ICS-20 transfer that timed
+// out, as the tokens are refunded to the sender.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) {
+	k.revertSentPacket(ctx, packet)
+}
+
+func (k Keeper) revertSentPacket(ctx sdk.Context, packet channeltypes.Packet) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return
+	}
+
+	k.RevertOutflow(ctx, packet.GetSourceChannel(), packet.GetSequence(), getSentDenom(data), amount)
+}
+
+// getSentDenom returns the denomination of the coins debited from the sender
+// of the transfer. The packet denomination is the full trace of IBC vouchers.
+func getSentDenom(data transfertypes.FungibleTokenPacketData) string {
+	return transfertypes.ParseDenomTrace(data.Denom).IBCDenom()
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+
+	"github.com/tharsis/evmos/x/ratelimit/types"
+)
+
+var _ types.ChannelKeeper = Keeper{}
+
+// SendPacket adds the sent ICS-20 transfer to the outflow of the source
+// channel and sends the packet through the IBC channel keeper. It returns an
+// error if the transfer exceeds the rate limit, which reverts the transfer.
+func (k Keeper) SendPacket(ctx sdk.Context, channelCap *capabilitytypes.Capability, packet exported.PacketI) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid ICS-20 transfer amount %s", data.Amount)
+	}
+
+	if err := k.AddOutflow(
+		ctx, packet.GetSourceChannel(), packet.GetSequence(), getSentDenom(data), amount,
+	); err != nil {
+		return err
+	}
+
+	return k.ics4Wrapper.SendPacket(ctx, channelCap, packet)
+}
+
+// GetChannel returns the channel from the IBC channel keeper.
+func (k Keeper) GetChannel(ctx sdk.Context, srcPort, srcChan string) (channeltypes.Channel, bool) {
+	return k.ics4Wrapper.GetChannel(ctx, srcPort, srcChan)
+}
+
+// GetNextSequenceSend returns the next send sequence from the IBC channel
+// keeper.
+func (k Keeper) GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool) {
+	return k.ics4Wrapper.GetNextSequenceSend(ctx, portID, channelID)
+}
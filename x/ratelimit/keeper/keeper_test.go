@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+
+	"github.com/tharsis/ethermint/tests"
+
+	"github.com/tharsis/evmos/app"
+	"github.com/tharsis/evmos/x/ratelimit/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *app.Evmos
+}
+
+// denomination of the uatom vouchers received over channel-0
+var atomDenom = transfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom()
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.Setup(false, nil)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: "evmos_9000-1",
+		Time:    time.Now().UTC(),
+	})
+
+	suite.app.RateLimitKeeper.SetParams(suite.ctx, types.NewParams(true, []types.RateLimit{
+		types.NewRateLimit("channel-0", "aphoton", sdk.NewInt(100), sdk.NewInt(50), time.Hour),
+		types.NewRateLimit("channel-0", atomDenom, sdk.NewInt(100), sdk.NewInt(100), time.Hour),
+	}))
+}
+
+func (suite *KeeperTestSuite) TestAddFlows() {
+	k := suite.app.RateLimitKeeper
+
+	// net inflow of 80
+	suite.Require().NoError(k.AddInflow(suite.ctx, "channel-0", "aphoton", sdk.NewInt(80)))
+	suite.Require().ErrorIs(k.AddInflow(suite.ctx, "channel-0", "aphoton", sdk.NewInt(21)), types.ErrRateLimitExceeded)
+
+	// the outflow offsets the inflow: net outflow of 50
+	suite.Require().NoError(k.AddOutflow(suite.ctx, "channel-0", 1, "aphoton", sdk.NewInt(130)))
+	suite.Require().ErrorIs(k.AddOutflow(suite.ctx, "channel-0", 2, "aphoton", sdk.NewInt(1)), types.ErrRateLimitExceeded)
+
+	flow, found := k.GetFlow(suite.ctx, "channel-0", "aphoton")
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(80), flow.Inflow)
+	suite.Require().Equal(sdk.NewInt(130), flow.Outflow)
+
+	// the refund of a sent transfer reverts its outflow
+	k.RevertOutflow(suite.ctx, "channel-0", 1, "aphoton", sdk.NewInt(30))
+	suite.Require().NoError(k.AddOutflow(suite.ctx, "channel-0", 3, "aphoton", sdk.NewInt(30)))
+
+	// the counters are reset once the window has elapsed
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(k.AddOutflow(ctx, "channel-0", 4, "aphoton", sdk.NewInt(50)))
+	flow, _ = k.GetFlow(ctx, "channel-0", "aphoton")
+	suite.Require().True(flow.Inflow.IsZero())
+	suite.Require().Equal(sdk.NewInt(50), flow.Outflow)
+	suite.Require().Equal(ctx.BlockTime(), flow.WindowStart)
+
+	// the refund of a transfer sent during the previous window doesn't give
+	// more quota in the current window
+	k.RevertOutflow(ctx, "channel-0", 3, "aphoton", sdk.NewInt(30))
+	flow, _ = k.GetFlow(ctx, "channel-0", "aphoton")
+	suite.Require().Equal(sdk.NewInt(50), flow.Outflow)
+	suite.Require().ErrorIs(k.AddOutflow(ctx, "channel-0", 5, "aphoton", sdk.NewInt(1)), types.ErrRateLimitExceeded)
+
+	_, found = k.GetSentPacket(ctx, "channel-0", 3)
+	suite.Require().False(found)
+
+	// transfers without a rate limit are not tracked
+	suite.Require().NoError(k.AddInflow(suite.ctx, "channel-1", "aphoton", sdk.NewInt(1000)))
+	_, found = k.GetFlow(suite.ctx, "channel-1", "aphoton")
+	suite.Require().False(found)
+
+	// rate limits disabled by governance
+	params := k.GetParams(suite.ctx)
+	params.EnableRateLimits = false
+	k.SetParams(suite.ctx, params)
+	suite.Require().NoError(k.AddInflow(suite.ctx, "channel-0", "aphoton", sdk.NewInt(1000)))
+	suite.Require().Len(k.GetAllFlows(suite.ctx), 1)
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacket() {
+	sender := "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc"
+	receiver := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+
+	newPacket := func(denom string, amount uint64) channeltypes.Packet {
+		data := transfertypes.NewFungibleTokenPacketData(denom, strconv.FormatUint(amount, 10), sender, receiver)
+		return channeltypes.NewPacket(
+			data.GetBytes(), 1, "transfer", "channel-1", "transfer", "channel-0", clienttypes.NewHeight(0, 100), 0,
+		)
+	}
+
+	successAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	errorAck := channeltypes.NewErrorAcknowledgement("failed")
+
+	// the failed transfers are not tracked
+	ack := suite.app.RateLimitKeeper.OnRecvPacket(suite.ctx, newPacket("uatom", 1000), errorAck)
+	suite.Require().Equal(errorAck, ack)
+
+	ack = suite.app.RateLimitKeeper.OnRecvPacket(suite.ctx, newPacket("uatom", 100), successAck)
+	suite.Require().True(ack.Success())
+
+	flow, found := suite.app.RateLimitKeeper.GetFlow(suite.ctx, "channel-0", atomDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(100), flow.Inflow)
+
+	ack = suite.app.RateLimitKeeper.OnRecvPacket(suite.ctx, newPacket("uatom", 1), successAck)
+	suite.Require().False(ack.Success())
+
+	// aphoton returning to Evmos is unprefixed
+	ack = suite.app.RateLimitKeeper.OnRecvPacket(suite.ctx, newPacket("transfer/channel-1/aphoton", 101), successAck)
+	suite.Require().False(ack.Success())
+}
+
+func (suite *KeeperTestSuite) TestSendPacket() {
+	sender := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+
+	newPacket := func(sequence uint64, denom string, amount uint64) channeltypes.Packet {
+		data := transfertypes.NewFungibleTokenPacketData(denom, strconv.FormatUint(amount, 10), sender, "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc")
+		return channeltypes.NewPacket(
+			data.GetBytes(), sequence, "transfer", "channel-0", "transfer", "channel-1", clienttypes.NewHeight(0, 100), 0,
+		)
+	}
+
+	// the rate limit is checked before the packet is sent
+	err := suite.app.RateLimitKeeper.SendPacket(suite.ctx, nil, newPacket(1, "aphoton", 51))
+	suite.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+
+	err = suite.app.RateLimitKeeper.SendPacket(suite.ctx, nil, newPacket(1, "transfer/channel-0/uatom", 101))
+	suite.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+
+	// refunded transfers revert the outflow
+	for i, amount := range []int64{20, 10, 20} {
+		suite.Require().NoError(suite.app.RateLimitKeeper.AddOutflow(suite.ctx, "channel-0", uint64(i+1), "aphoton", sdk.NewInt(amount)))
+	}
+
+	errorAck := channeltypes.NewErrorAcknowledgement("failed")
+	suite.app.RateLimitKeeper.OnAcknowledgementPacket(suite.ctx, newPacket(1, "aphoton", 20), errorAck.Acknowledgement())
+	suite.app.RateLimitKeeper.OnTimeoutPacket(suite.ctx, newPacket(2, "aphoton", 10))
+
+	flow, _ := suite.app.RateLimitKeeper.GetFlow(suite.ctx, "channel-0", "aphoton")
+	suite.Require().Equal(sdk.NewInt(20), flow.Outflow)
+
+	// a packet is only reverted once
+	suite.app.RateLimitKeeper.OnTimeoutPacket(suite.ctx, newPacket(2, "aphoton", 10))
+	flow, _ = suite.app.RateLimitKeeper.GetFlow(suite.ctx, "channel-0", "aphoton")
+	suite.Require().Equal(sdk.NewInt(20), flow.Outflow)
+
+	// successful transfers are not reverted
+	successAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	suite.app.RateLimitKeeper.OnAcknowledgementPacket(suite.ctx, newPacket(3, "aphoton", 20), successAck.Acknowledgement())
+	flow, _ = suite.app.RateLimitKeeper.GetFlow(suite.ctx, "channel-0", "aphoton")
+	suite.Require().Equal(sdk.NewInt(20), flow.Outflow)
+	suite.Require().Empty(suite.app.RateLimitKeeper.GetAllSentPackets(suite.ctx))
+}
@@ -0,0 +1,12 @@
+package types
+
+// ratelimit events
+const (
+	EventTypeRateLimitExceeded = "rate_limit_exceeded"
+
+	AttributeKeyChannel   = "channel"
+	AttributeKeyDirection = "direction"
+
+	AttributeValueInflow  = "inflow"
+	AttributeValueOutflow = "outflow"
+)
@@ -0,0 +1,55 @@
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, flows []Flow, sentPackets []SentPacket) GenesisState {
+	return GenesisState{
+		Params:      params,
+		Flows:       flows,
+		SentPackets: sentPackets,
+	}
+}
+
+// DefaultGenesisState returns the default ratelimit module genesis state
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:      DefaultParams(),
+		Flows:       []Flow{},
+		SentPackets: []SentPacket{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool)
+	for _, flow := range gs.Flows {
+		if err := flow.Validate(); err != nil {
+			return fmt.Errorf("invalid flow: %w", err)
+		}
+
+		key := string(GetFlowKey(flow.Channel, flow.Denom))
+		if seen[key] {
+			return fmt.Errorf("duplicated flow for channel %s and denom %s", flow.Channel, flow.Denom)
+		}
+
+		seen[key] = true
+	}
+
+	seenPackets := make(map[string]bool)
+	for _, sp := range gs.SentPackets {
+		if err := sp.Validate(); err != nil {
+			return fmt.Errorf("invalid sent packet: %w", err)
+		}
+
+		key := string(GetSentPacketKey(sp.Channel, sp.Sequence))
+		if seenPackets[key] {
+			return fmt.Errorf("duplicated sent packet for channel %s and sequence %d", sp.Channel, sp.Sequence)
+		}
+
+		seenPackets[key] = true
+	}
+
+	return gs.Params.Validate()
+}
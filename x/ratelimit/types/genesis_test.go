@@ -0,0 +1,89 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	rateLimit := NewRateLimit("channel-0", "aphoton", sdk.NewInt(100), sdk.NewInt(50), time.Hour)
+	flow := NewFlow("channel-0", "aphoton", time.Now().UTC())
+
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expPass  bool
+	}{
+		{"default", DefaultGenesisState(), true},
+		{
+			"rate limits and flows",
+			&GenesisState{
+				Params: NewParams(true, []RateLimit{rateLimit, NewRateLimit("channel-1", "aphoton", sdk.ZeroInt(), sdk.ZeroInt(), time.Minute)}),
+				Flows:  []Flow{flow},
+			},
+			true,
+		},
+		{
+			"invalid channel",
+			&GenesisState{Params: NewParams(true, []RateLimit{NewRateLimit("channel", "aphoton", sdk.NewInt(100), sdk.NewInt(50), time.Hour)})},
+			false,
+		},
+		{
+			"invalid denom",
+			&GenesisState{Params: NewParams(true, []RateLimit{NewRateLimit("channel-0", "", sdk.NewInt(100), sdk.NewInt(50), time.Hour)})},
+			false,
+		},
+		{
+			"negative max inflow",
+			&GenesisState{Params: NewParams(true, []RateLimit{NewRateLimit("channel-0", "aphoton", sdk.NewInt(-1), sdk.NewInt(50), time.Hour)})},
+			false,
+		},
+		{
+			"zero window",
+			&GenesisState{Params: NewParams(true, []RateLimit{NewRateLimit("channel-0", "aphoton", sdk.NewInt(100), sdk.NewInt(50), 0)})},
+			false,
+		},
+		{
+			"duplicated rate limit",
+			&GenesisState{Params: NewParams(true, []RateLimit{rateLimit, rateLimit})},
+			false,
+		},
+		{
+			"duplicated flow",
+			&GenesisState{Params: DefaultParams(), Flows: []Flow{flow, flow}},
+			false,
+		},
+		{
+			"negative flow",
+			&GenesisState{Params: DefaultParams(), Flows: []Flow{{Channel: "channel-0", Denom: "aphoton", Inflow: sdk.NewInt(-1), Outflow: sdk.ZeroInt()}}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestGetRateLimit(t *testing.T) {
+	rateLimit := NewRateLimit("channel-0", "aphoton", sdk.NewInt(100), sdk.NewInt(50), time.Hour)
+	params := NewParams(true, []RateLimit{rateLimit})
+
+	rl, found := params.GetRateLimit("channel-0", "aphoton")
+	require.True(t, found)
+	require.Equal(t, rateLimit, rl)
+
+	_, found = params.GetRateLimit("channel-1", "aphoton")
+	require.False(t, found)
+	_, found = DefaultParams().GetRateLimit("channel-0", "aphoton")
+	require.False(t, found)
+}
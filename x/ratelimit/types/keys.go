@@ -0,0 +1,40 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// constants
+const (
+	// module name
+	ModuleName = "ratelimit"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the ratelimit persistent store
+const (
+	prefixFlow = iota + 1
+	prefixSentPacket
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixFlow       = []byte{prefixFlow}
+	KeyPrefixSentPacket = []byte{prefixSentPacket}
+)
+
+// GetFlowKey returns the key of the flow of the given channel and
+// denomination, without the prefix. Channel identifiers can't contain a slash,
+// so the separator keeps the keys of different channels apart.
+func GetFlowKey(channel, denom string) []byte {
+	return []byte(channel + "/" + denom)
+}
+
+// GetSentPacketKey returns the key of the sent packet of the given channel and
+// sequence, without the prefix.
+func GetSentPacketKey(channel string, sequence uint64) []byte {
+	return append([]byte(channel+"/"), sdk.Uint64ToBigEndian(sequence)...)
+}
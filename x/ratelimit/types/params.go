@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// Parameter store key
+var (
+	ParamStoreKeyEnableRateLimits = []byte("EnableRateLimits")
+	ParamStoreKeyRateLimits       = []byte("RateLimits")
+)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(enableRateLimits bool, rateLimits []RateLimit) Params {
+	return Params{
+		EnableRateLimits: enableRateLimits,
+		RateLimits:       rateLimits,
+	}
+}
+
+// DefaultParams returns default ratelimit module parameters. The rate limits
+// are enabled but no transfer is limited until governance adds rate limits.
+func DefaultParams() Params {
+	return Params{
+		EnableRateLimits: true,
+		RateLimits:       []RateLimit{},
+	}
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyEnableRateLimits, &p.EnableRateLimits, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyRateLimits, &p.RateLimits, validateRateLimits),
+	}
+}
+
+// Validate performs a stateless validation of the ratelimit module parameters.
+func (p Params) Validate() error {
+	if err := validateBool(p.EnableRateLimits); err != nil {
+		return err
+	}
+
+	return validateRateLimits(p.RateLimits)
+}
+
+// GetRateLimit returns the rate limit of the given channel and denomination.
+// It returns false if the transfers are not limited.
+func (p Params) GetRateLimit(channel, denom string) (RateLimit, bool) {
+	for _, rl := range p.RateLimits {
+		if rl.Channel == channel && rl.Denom == denom {
+			return rl, true
+		}
+	}
+
+	return RateLimit{}, false
+}
+
+func validateBool(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateRateLimits(i interface{}) error {
+	rateLimits, ok := i.([]RateLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, rl := range rateLimits {
+		if err := rl.Validate(); err != nil {
+			return fmt.Errorf("invalid rate limit: %w", err)
+		}
+
+		key := string(GetFlowKey(rl.Channel, rl.Denom))
+		if seen[key] {
+			return fmt.Errorf("duplicated rate limit for channel %s and denom %s", rl.Channel, rl.Denom)
+		}
+
+		seen[key] = true
+	}
+
+	return nil
+}
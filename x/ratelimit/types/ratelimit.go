@@ -0,0 +1,112 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// NewRateLimit returns a new RateLimit instance
+func NewRateLimit(channel, denom string, maxInflow, maxOutflow sdk.Int, window time.Duration) RateLimit {
+	return RateLimit{
+		Channel:    channel,
+		Denom:      denom,
+		MaxInflow:  maxInflow,
+		MaxOutflow: maxOutflow,
+		Window:     window,
+	}
+}
+
+// Validate performs a stateless validation of the rate limit fields
+func (rl RateLimit) Validate() error {
+	if err := host.ChannelIdentifierValidator(rl.Channel); err != nil {
+		return fmt.Errorf("invalid channel: %w", err)
+	}
+
+	if err := sdk.ValidateDenom(rl.Denom); err != nil {
+		return err
+	}
+
+	if rl.MaxInflow.IsNil() || rl.MaxInflow.IsNegative() {
+		return fmt.Errorf("max inflow cannot be nil or negative: %s", rl.MaxInflow)
+	}
+
+	if rl.MaxOutflow.IsNil() || rl.MaxOutflow.IsNegative() {
+		return fmt.Errorf("max outflow cannot be nil or negative: %s", rl.MaxOutflow)
+	}
+
+	if rl.Window <= 0 {
+		return fmt.Errorf("window must be positive: %s", rl.Window)
+	}
+
+	return nil
+}
+
+// NewFlow returns a new Flow instance with empty counters
+func NewFlow(channel, denom string, windowStart time.Time) Flow {
+	return Flow{
+		Channel:     channel,
+		Denom:       denom,
+		Inflow:      sdk.ZeroInt(),
+		Outflow:     sdk.ZeroInt(),
+		WindowStart: windowStart,
+	}
+}
+
+// NewSentPacket returns a new SentPacket instance
+func NewSentPacket(channel string, sequence uint64, windowStart time.Time) SentPacket {
+	return SentPacket{
+		Channel:     channel,
+		Sequence:    sequence,
+		WindowStart: windowStart,
+	}
+}
+
+// NetInflow returns the received amount minus the sent amount. It is negative
+// if more coins have been sent than received.
+func (f Flow) NetInflow() sdk.Int {
+	return f.Inflow.Sub(f.Outflow)
+}
+
+// NetOutflow returns the sent amount minus the received amount. It is
+// negative if more coins have been received than sent.
+func (f Flow) NetOutflow() sdk.Int {
+	return f.Outflow.Sub(f.Inflow)
+}
+
+// Validate performs a stateless validation of the flow fields
+func (f Flow) Validate() error {
+	if err := host.ChannelIdentifierValidator(f.Channel); err != nil {
+		return fmt.Errorf("invalid channel: %w", err)
+	}
+
+	if err := sdk.ValidateDenom(f.Denom); err != nil {
+		return err
+	}
+
+	if f.Inflow.IsNil() || f.Inflow.IsNegative() {
+		return fmt.Errorf("inflow cannot be nil or negative: %s", f.Inflow)
+	}
+
+	if f.Outflow.IsNil() || f.Outflow.IsNegative() {
+		return fmt.Errorf("outflow cannot be nil or negative: %s", f.Outflow)
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of the sent packet fields
+func (sp SentPacket) Validate() error {
+	if err := host.ChannelIdentifierValidator(sp.Channel); err != nil {
+		return fmt.Errorf("invalid channel: %w", err)
+	}
+
+	if sp.Sequence == 0 {
+		return fmt.Errorf("packet sequence cannot be 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+
+	"github.com/tharsis/evmos/x/recovery/types"
+)
+
+// OnRecvPacket returns an error acknowledgement for the ICS-20 transfers
+// received on an authorized channel by an address with the same bytes as the
+// sender address on the source chain. Such a recipient is the bech32 form of a
+// key derived with the Cosmos coin type (118), which can't sign for its Evmos
+// address, derived with the Ethereum coin type (60). The error acknowledgement
+// discards the received tokens and refunds the sender on the source chain.
+// Recipients that have already signed a transaction with an Ethereum key are
+// never recovered.
+func (k Keeper) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ack exported.Acknowledgement,
+) exported.Acknowledgement {
+	// the transfer failed, so there are no tokens to recover
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	params := k.GetParams(ctx)
+	if !params.EnableRecovery || !params.IsAuthorizedChannel(packet.DestinationChannel) {
+		return ack
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return ack
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return ack
+	}
+
+	// the sender address uses the bech32 prefix of the source chain
+	_, sender, err := bech32.DecodeAndConvert(data.Sender)
+	if err != nil || !bytes.Equal(sender, recipient) {
+		return ack
+	}
+
+	if acc := k.accountKeeper.GetAccount(ctx, recipient); acc != nil {
+		if _, ok := acc.GetPubKey().(*ethsecp256k1.PubKey); ok {
+			return ack
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecovery,
+			sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
+			sdk.NewAttribute(types.AttributeKeyRecipient, data.Receiver),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, data.Amount),
+			sdk.NewAttribute(transfertypes.AttributeKeyDenom, data.Denom),
+			sdk.NewAttribute(types.AttributeKeyChannel, packet.DestinationChannel),
+		),
+	)
+
+	return channeltypes.NewErrorAcknowledgement(
+		sdkerrors.Wrapf(
+			types.ErrUnreachableRecipient,
+			"%s has the same address as the sender %s, refunding the transfer", data.Receiver, data.Sender,
+		).Error(),
+	)
+}
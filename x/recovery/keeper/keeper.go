@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/tharsis/evmos/x/recovery/types"
+)
+
+// Keeper of this module rejects the IBC transfers sent to recipients that
+// can't sign for their Evmos address.
+type Keeper struct {
+	paramstore paramtypes.Subspace
+
+	accountKeeper types.AccountKeeper
+}
+
+// NewKeeper creates new instances of the recovery Keeper
+func NewKeeper(
+	ps paramtypes.Subspace,
+	ak types.AccountKeeper,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		paramstore:    ps,
+		accountKeeper: ak,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+	"github.com/tharsis/ethermint/tests"
+
+	"github.com/tharsis/evmos/app"
+	"github.com/tharsis/evmos/x/recovery/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *app.Evmos
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.Setup(false, nil)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: "evmos_9000-1",
+		Time:    time.Now().UTC(),
+	})
+
+	suite.app.RecoveryKeeper.SetParams(suite.ctx, types.NewParams(true, []string{"channel-0"}))
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacket() {
+	// the same key on the Cosmos Hub and on Evmos
+	recipient := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	sender, err := bech32.ConvertAndEncode("cosmos", recipient)
+	suite.Require().NoError(err)
+
+	otherSender, err := bech32.ConvertAndEncode("cosmos", tests.GenerateAddress().Bytes())
+	suite.Require().NoError(err)
+
+	// recipient that has already signed with an Ethereum key
+	privKey, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	signer := sdk.AccAddress(privKey.PubKey().Address())
+	signerAcc := authtypes.NewBaseAccount(signer, privKey.PubKey(), 0, 0)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccount(suite.ctx, signerAcc))
+	signerSender, err := bech32.ConvertAndEncode("cosmos", signer)
+	suite.Require().NoError(err)
+
+	newPacket := func(sender string, receiver sdk.AccAddress, channel string) channeltypes.Packet {
+		data := transfertypes.NewFungibleTokenPacketData("uatom", "40", sender, receiver.String())
+		return channeltypes.NewPacket(
+			data.GetBytes(), 1, "transfer", "channel-1", "transfer", channel, clienttypes.NewHeight(0, 100), 0,
+		)
+	}
+
+	successAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	errorAck := channeltypes.NewErrorAcknowledgement("failed")
+
+	testCases := []struct {
+		name       string
+		packet     channeltypes.Packet
+		ack        exported.Acknowledgement
+		expRecover bool
+	}{
+		{"error acknowledgement", newPacket(sender, recipient, "channel-0"), errorAck, false},
+		{"unauthorized channel", newPacket(sender, recipient, "channel-1"), successAck, false},
+		{"different sender", newPacket(otherSender, recipient, "channel-0"), successAck, false},
+		{"ethereum key recipient", newPacket(signerSender, signer, "channel-0"), successAck, false},
+		{"recovered", newPacket(sender, recipient, "channel-0"), successAck, true},
+	}
+
+	for _, tc := range testCases {
+		ack := suite.app.RecoveryKeeper.OnRecvPacket(suite.ctx, tc.packet, tc.ack)
+		if tc.expRecover {
+			suite.Require().False(ack.Success(), tc.name)
+		} else {
+			suite.Require().Equal(tc.ack, ack, tc.name)
+		}
+	}
+
+	// recovery disabled by governance
+	suite.app.RecoveryKeeper.SetParams(suite.ctx, types.NewParams(false, []string{"channel-0"}))
+	ack := suite.app.RecoveryKeeper.OnRecvPacket(suite.ctx, newPacket(sender, recipient, "channel-0"), successAck)
+	suite.Require().True(ack.Success())
+}
@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// errors
+var (
+	ErrUnreachableRecipient = sdkerrors.Register(ModuleName, 2, "recipient address can't be signed for on Evmos")
+)
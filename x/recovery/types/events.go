@@ -0,0 +1,9 @@
+package types
+
+// recovery events
+const (
+	EventTypeRecovery = "recovery"
+
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyChannel   = "channel"
+)
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expPass  bool
+	}{
+		{"default", DefaultGenesisState(), true},
+		{"authorized channels", &GenesisState{Params: NewParams(true, []string{"channel-0", "channel-3"})}, true},
+		{"invalid channel", &GenesisState{Params: NewParams(true, []string{"channel"})}, false},
+		{"duplicated channel", &GenesisState{Params: NewParams(true, []string{"channel-0", "channel-0"})}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestIsAuthorizedChannel(t *testing.T) {
+	params := NewParams(true, []string{"channel-0"})
+	require.True(t, params.IsAuthorizedChannel("channel-0"))
+	require.False(t, params.IsAuthorizedChannel("channel-1"))
+	require.False(t, DefaultParams().IsAuthorizedChannel("channel-0"))
+}
@@ -0,0 +1,10 @@
+package types
+
+// constants
+const (
+	// module name
+	ModuleName = "recovery"
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// Parameter store key
+var (
+	ParamStoreKeyEnableRecovery     = []byte("EnableRecovery")
+	ParamStoreKeyAuthorizedChannels = []byte("AuthorizedChannels")
+)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(enableRecovery bool, authorizedChannels []string) Params {
+	return Params{
+		EnableRecovery:     enableRecovery,
+		AuthorizedChannels: authorizedChannels,
+	}
+}
+
+// DefaultParams returns default recovery module parameters. The recovery is
+// enabled but no channel is authorized until governance adds them.
+func DefaultParams() Params {
+	return Params{
+		EnableRecovery:     true,
+		AuthorizedChannels: []string{},
+	}
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyEnableRecovery, &p.EnableRecovery, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyAuthorizedChannels, &p.AuthorizedChannels, validateChannels),
+	}
+}
+
+// Validate performs a stateless validation of the recovery module parameters.
+func (p Params) Validate() error {
+	if err := validateBool(p.EnableRecovery); err != nil {
+		return err
+	}
+
+	return validateChannels(p.AuthorizedChannels)
+}
+
+// IsAuthorizedChannel returns true if the recovery is enabled on the given
+// channel.
+func (p Params) IsAuthorizedChannel(channel string) bool {
+	for _, authorized := range p.AuthorizedChannels {
+		if authorized == channel {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateBool(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateChannels(i interface{}) error {
+	channels, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, channel := range channels {
+		if err := host.ChannelIdentifierValidator(channel); err != nil {
+			return fmt.Errorf("invalid authorized channel: %w", err)
+		}
+
+		if seen[channel] {
+			return fmt.Errorf("duplicated authorized channel %s", channel)
+		}
+
+		seen[channel] = true
+	}
+
+	return nil
+}
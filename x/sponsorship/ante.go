@@ -0,0 +1,48 @@
+package sponsorship
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	evmostypes "github.com/tharsis/evmos/types"
+	"github.com/tharsis/evmos/x/sponsorship/keeper"
+)
+
+// SponsorshipDecorator pays the fees of the Ethereum transactions calling a
+// sponsored contract from the contract sponsor pool, within the per-user and
+// per-block sponsorship limits. The sponsored fee is sent to the sender before
+// it is deducted and the fee of the unused gas is returned to the sponsor pool
+// once the transaction has been executed. The transactions that set a fee
+// granter are left to the fee grants.
+//
+// NOTE: the decorator must run after the signature verification of the
+// Ethereum AnteHandler, which authenticates the sender.
+type SponsorshipDecorator struct {
+	keeper keeper.Keeper
+}
+
+// NewSponsorshipDecorator creates a new SponsorshipDecorator
+func NewSponsorshipDecorator(k keeper.Keeper) SponsorshipDecorator {
+	return SponsorshipDecorator{
+		keeper: k,
+	}
+}
+
+// AnteHandle sponsors the fee of the Ethereum transactions calling a
+// sponsored contract before calling the next AnteHandler.
+func (sd SponsorshipDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if feeTx, ok := tx.(sdk.FeeTx); ok && !feeTx.FeeGranter().Empty() {
+		return next(ctx, tx, simulate)
+	}
+
+	msgEthTx, sender, ok := evmostypes.GetEthereumTx(tx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	if _, err := sd.keeper.SponsorTx(ctx, sender, msgEthTx); err != nil {
+		return ctx, sdkerrors.Wrap(err, "failed to sponsor transaction")
+	}
+
+	return next(ctx, tx, simulate)
+}
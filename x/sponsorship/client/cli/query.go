@@ -0,0 +1,163 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/tharsis/evmos/x/sponsorship/types"
+)
+
+// GetQueryCmd returns the parent command for all sponsorship CLI query commands
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the sponsorship module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetSponsorshipsCmd(),
+		GetSponsorshipCmd(),
+		GetUserGasCmd(),
+		GetParamsCmd(),
+	)
+	return cmd
+}
+
+// GetSponsorshipsCmd queries all the sponsored contracts
+func GetSponsorshipsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sponsorships",
+		Short: "Gets all sponsored contracts",
+		Long:  "Gets all sponsored contracts along with the remaining balance of their sponsor pool",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySponsorshipsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Sponsorships(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "sponsorships")
+	return cmd
+}
+
+// GetSponsorshipCmd queries the sponsorship of a contract
+func GetSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sponsorship [contract_hex]",
+		Short: "Gets a sponsored contract",
+		Long:  "Gets a sponsored contract by its hex address, along with the remaining balance of its sponsor pool",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySponsorshipRequest{
+				ContractAddress: args[0],
+			}
+
+			res, err := queryClient.Sponsorship(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetUserGasCmd queries the gas sponsored for a user
+func GetUserGasCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "user-gas [address]",
+		Short: "Gets the gas sponsored for a user during the current epoch",
+		Long:  "Gets the gas sponsored for a user, by its hex or bech32 address, during the current epoch and the gas that can still be sponsored",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryUserGasRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.UserGas(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetParamsCmd queries the module parameters
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Gets sponsorship params",
+		Long:  "Gets sponsorship params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryParamsRequest{}
+
+			res, err := queryClient.Params(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
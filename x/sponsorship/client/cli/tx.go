@@ -0,0 +1,268 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/tharsis/evmos/x/sponsorship/types"
+)
+
+// NewTxCmd returns a root CLI command handler for sponsorship transaction
+// commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "sponsorship subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRegisterSponsorshipCmd(),
+		NewFundSponsorshipCmd(),
+		NewCancelSponsorshipCmd(),
+	)
+	return txCmd
+}
+
+// NewRegisterSponsorshipCmd returns a CLI command handler for sponsoring the
+// calls of a contract
+func NewRegisterSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register [contract_hex] [nonces] [amount]",
+		Short: "Sponsor the calls of a contract deployed by the sender. The nonces are the comma separated nonces of the address derivation path from the sender to the contract. The amount is the initial deposit in the sponsor pool.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if !common.IsHexAddress(contract) {
+				return fmt.Errorf("invalid contract hex address %s", contract)
+			}
+
+			var nonces []uint64
+			for _, n := range strings.Split(args[1], ",") {
+				nonce, err := strconv.ParseUint(strings.TrimSpace(n), 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid nonce %s: %w", n, err)
+				}
+				nonces = append(nonces, nonce)
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterSponsorship(common.HexToAddress(contract), cliCtx.GetFromAddress(), nonces, amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewFundSponsorshipCmd returns a CLI command handler for depositing funds in
+// the sponsor pool of a contract
+func NewFundSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fund [contract_hex] [amount]",
+		Short:   "Deposit funds in the sponsor pool of a sponsored contract",
+		Example: fmt.Sprintf("$ %s tx %s fund <contract_address> 1000000000000000000aevmos --from=<key_or_address>", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if !common.IsHexAddress(contract) {
+				return fmt.Errorf("invalid contract hex address %s", contract)
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFundSponsorship(common.HexToAddress(contract), cliCtx.GetFromAddress(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelSponsorshipCmd returns a CLI command handler for cancelling the
+// sponsorship of a contract
+func NewCancelSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [contract_hex]",
+		Short: "Cancel the sponsorship of a contract registered by the sender and withdraw the remaining sponsor pool",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if !common.IsHexAddress(contract) {
+				return fmt.Errorf("invalid contract hex address %s", contract)
+			}
+
+			msg := types.NewMsgCancelSponsorship(common.HexToAddress(contract), cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRegisterSponsorshipProposalCmd implements the command to submit a register-sponsorship proposal
+func NewRegisterSponsorshipProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register-sponsorship [contract-address] [amount]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Submit a proposal to sponsor the calls of a contract along with an initial deposit. The amount is sent from the community pool to the sponsor pool.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal register-sponsorship <contract_address> 1000000000000000000aevmos --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := readProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewRegisterSponsorshipProposal(title, description, args[0], amount)
+
+			return submitProposal(cmd, clientCtx, content, deposit)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewCancelSponsorshipProposalCmd implements the command to submit a cancel-sponsorship proposal
+func NewCancelSponsorshipProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-sponsorship [contract-address]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a proposal to cancel the sponsorship of a contract along with an initial deposit.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal cancel-sponsorship <contract_address> --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := readProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCancelSponsorshipProposal(title, description, args[0])
+
+			return submitProposal(cmd, clientCtx, content, deposit)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// addProposalFlags adds the common governance proposal flags to the command
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(govcli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(govcli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(govcli.FlagDeposit); err != nil {
+		panic(err)
+	}
+}
+
+// readProposalFlags returns the title, description and deposit of a proposal
+func readProposalFlags(cmd *cobra.Command) (string, string, sdk.Coins, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	return title, description, deposit, nil
+}
+
+// submitProposal builds and broadcasts a MsgSubmitProposal for the given content
+func submitProposal(cmd *cobra.Command, clientCtx client.Context, content govtypes.Content, deposit sdk.Coins) error {
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
@@ -0,0 +1,15 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/tharsis/evmos/x/sponsorship/client/cli"
+	"github.com/tharsis/evmos/x/sponsorship/client/rest"
+)
+
+var (
+	// RegisterSponsorshipProposalHandler is the CLI and REST handler for the register sponsorship proposal
+	RegisterSponsorshipProposalHandler = govclient.NewProposalHandler(cli.NewRegisterSponsorshipProposalCmd, rest.RegisterSponsorshipProposalRequestHandler)
+	// CancelSponsorshipProposalHandler is the CLI and REST handler for the cancel sponsorship proposal
+	CancelSponsorshipProposalHandler = govclient.NewProposalHandler(cli.NewCancelSponsorshipProposalCmd, rest.CancelSponsorshipProposalRequestHandler)
+)
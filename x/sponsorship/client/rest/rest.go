@@ -0,0 +1,112 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/tharsis/evmos/x/sponsorship/types"
+)
+
+// RegisterSponsorshipProposalRequest defines a request for a new register sponsorship proposal.
+type RegisterSponsorshipProposalRequest struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Contract    string       `json:"contract" yaml:"contract"`
+	Amount      sdk.Coin     `json:"amount" yaml:"amount"`
+}
+
+// CancelSponsorshipProposalRequest defines a request for a cancel sponsorship proposal.
+type CancelSponsorshipProposalRequest struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Contract    string       `json:"contract" yaml:"contract"`
+}
+
+// RegisterSponsorshipProposalRequestHandler returns the REST handler for the register sponsorship proposal
+func RegisterSponsorshipProposalRequestHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: types.ModuleName,
+		Handler:  newRegisterSponsorshipProposalHandler(clientCtx),
+	}
+}
+
+// CancelSponsorshipProposalRequestHandler returns the REST handler for the cancel sponsorship proposal
+func CancelSponsorshipProposalRequestHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: types.ModuleName,
+		Handler:  newCancelSponsorshipProposalHandler(clientCtx),
+	}
+}
+
+func newRegisterSponsorshipProposalHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RegisterSponsorshipProposalRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		if !common.IsHexAddress(req.Contract) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid contract address")
+			return
+		}
+
+		content := types.NewRegisterSponsorshipProposal(req.Title, req.Description, req.Contract, req.Amount)
+		writeProposalTx(w, clientCtx, req.BaseReq, content, req.Deposit)
+	}
+}
+
+func newCancelSponsorshipProposalHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CancelSponsorshipProposalRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		if !common.IsHexAddress(req.Contract) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid contract address")
+			return
+		}
+
+		content := types.NewCancelSponsorshipProposal(req.Title, req.Description, req.Contract)
+		writeProposalTx(w, clientCtx, req.BaseReq, content, req.Deposit)
+	}
+}
+
+// writeProposalTx validates the base request and writes the generated
+// MsgSubmitProposal transaction to the response
+func writeProposalTx(w http.ResponseWriter, clientCtx client.Context, baseReq rest.BaseReq, content govtypes.Content, deposit sdk.Coins) {
+	baseReq = baseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return
+	}
+
+	fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, fromAddr)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+
+	if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+		return
+	}
+
+	tx.WriteGeneratedTxResponse(clientCtx, w, baseReq, msg)
+}
@@ -0,0 +1,36 @@
+package sponsorship
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+
+	"github.com/tharsis/evmos/x/sponsorship/keeper"
+	"github.com/tharsis/evmos/x/sponsorship/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	accountKeeper authkeeper.AccountKeeper,
+	data types.GenesisState,
+) {
+	k.SetParams(ctx, data.Params)
+
+	// ensure sponsorship module account is set on genesis
+	if acc := accountKeeper.GetModuleAccount(ctx, types.ModuleName); acc == nil {
+		panic("the sponsorship module account has not been set")
+	}
+
+	for _, sponsorship := range data.Sponsorships {
+		k.SetSponsorship(ctx, sponsorship)
+	}
+}
+
+// ExportGenesis export module status
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:       k.GetParams(ctx),
+		Sponsorships: k.GetAllSponsorships(ctx),
+	}
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/x/sponsorship/types"
+)
+
+// GetUserGas returns the gas sponsored for a user during the current epoch
+func (k Keeper) GetUserGas(ctx sdk.Context, user common.Address) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUserGas)
+	bz := store.Get(user.Bytes())
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetUserGas sets the gas sponsored for a user during the current epoch
+func (k Keeper) SetUserGas(ctx sdk.Context, user common.Address, gas uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUserGas)
+	store.Set(user.Bytes(), sdk.Uint64ToBigEndian(gas))
+}
+
+// ResetUsersGas removes the gas sponsored for every user
+func (k Keeper) ResetUsersGas(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUserGas)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	// the keys are collected first, as the store can't be modified while
+	// iterating
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetBlockGas returns the gas sponsored during the current block
+func (k Keeper) GetBlockGas(ctx sdk.Context) uint64 {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.KeyPrefixTransientBlockGas)
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetBlockGas sets the gas sponsored during the current block
+func (k Keeper) SetBlockGas(ctx sdk.Context, gas uint64) {
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientBlockGas, sdk.Uint64ToBigEndian(gas))
+}
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	ethermint "github.com/tharsis/ethermint/types"
+
+	"github.com/tharsis/evmos/x/sponsorship/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Sponsorships returns all the sponsored contracts
+func (k Keeper) Sponsorships(c context.Context, req *types.QuerySponsorshipsRequest) (*types.QuerySponsorshipsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var sponsorships []types.Sponsorship
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSponsorship)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var sponsorship types.Sponsorship
+		if err := k.cdc.Unmarshal(value, &sponsorship); err != nil {
+			return err
+		}
+		sponsorships = append(sponsorships, sponsorship)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySponsorshipsResponse{
+		Sponsorships: sponsorships,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// Sponsorship returns the sponsorship of a given contract, along with the
+// remaining balance of its sponsor pool
+func (k Keeper) Sponsorship(c context.Context, req *types.QuerySponsorshipRequest) (*types.QuerySponsorshipResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ethermint.ValidateAddress(req.ContractAddress); err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid format for contract %s, should be hex ('0x...')", req.ContractAddress,
+		)
+	}
+
+	sponsorship, found := k.GetSponsorship(ctx, common.HexToAddress(req.ContractAddress))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "sponsored contract '%s'", req.ContractAddress)
+	}
+
+	return &types.QuerySponsorshipResponse{Sponsorship: sponsorship}, nil
+}
+
+// UserGas returns the gas sponsored for a user during the current epoch and
+// the gas that can still be sponsored
+func (k Keeper) UserGas(c context.Context, req *types.QueryUserGasRequest) (*types.QueryUserGasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var user common.Address
+	if common.IsHexAddress(req.Address) {
+		user = common.HexToAddress(req.Address)
+	} else {
+		addr, err := sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"invalid format for user %s, should be hex ('0x...') or bech32 ('evmos...')", req.Address,
+			)
+		}
+		user = common.BytesToAddress(addr)
+	}
+
+	gasUsed := k.GetUserGas(ctx, user)
+
+	var gasRemaining uint64
+	if maxGas := k.GetParams(ctx).MaxGasPerUser; gasUsed < maxGas {
+		gasRemaining = maxGas - gasUsed
+	}
+
+	return &types.QueryUserGasResponse{
+		GasUsed:      gasUsed,
+		GasRemaining: gasRemaining,
+	}, nil
+}
+
+// Params returns the sponsorship module parameters
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
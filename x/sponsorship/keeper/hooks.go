@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/tharsis/evmos/x/epochs/types"
+)
+
+var _ epochstypes.EpochHooks = Keeper{}
+
+// BeforeEpochStart performs a no-op
+func (k Keeper) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {}
+
+// AfterEpochEnd resets the gas sponsored for every user at the end of every
+// sponsorship epoch
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ int64) {
+	if epochIdentifier != k.GetParams(ctx).EpochIdentifier {
+		return
+	}
+
+	k.ResetUsersGas(ctx)
+}
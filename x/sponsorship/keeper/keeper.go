@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/tharsis/evmos/x/sponsorship/types"
+)
+
+// Keeper of this module maintains the sponsored contracts and pays the fees
+// of their callers from the sponsor pools.
+type Keeper struct {
+	storeKey     sdk.StoreKey
+	transientKey sdk.StoreKey
+	cdc          codec.BinaryCodec
+	paramstore   paramtypes.Subspace
+
+	accountKeeper   types.AccountKeeper
+	bankKeeper      types.BankKeeper
+	distrKeeper     types.DistrKeeper
+	evmKeeper       types.EVMKeeper
+	feeMarketKeeper types.FeeMarketKeeper
+}
+
+// NewKeeper creates new instances of the sponsorship Keeper
+func NewKeeper(
+	storeKey, transientKey sdk.StoreKey,
+	cdc codec.BinaryCodec,
+	ps paramtypes.Subspace,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	dk types.DistrKeeper,
+	evmKeeper types.EVMKeeper,
+	fmk types.FeeMarketKeeper,
+) Keeper {
+	// ensure the sponsorship module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the sponsorship module account has not been set")
+	}
+
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:        storeKey,
+		transientKey:    transientKey,
+		cdc:             cdc,
+		paramstore:      ps,
+		accountKeeper:   ak,
+		bankKeeper:      bk,
+		distrKeeper:     dk,
+		evmKeeper:       evmKeeper,
+		feeMarketKeeper: fmk,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
@@ -0,0 +1,257 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/tharsis/ethermint/tests"
+	ethermint "github.com/tharsis/ethermint/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/tharsis/evmos/app"
+	erc20types "github.com/tharsis/evmos/x/erc20/types"
+	"github.com/tharsis/evmos/x/sponsorship"
+	"github.com/tharsis/evmos/x/sponsorship/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx      sdk.Context
+	app      *app.Evmos
+	evmDenom string
+	deployer sdk.AccAddress
+	contract common.Address
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.Setup(false, nil)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: "evmos_9000-1",
+		Time:    time.Now().UTC(),
+	})
+	suite.app.EvmKeeper.WithChainID(suite.ctx)
+	suite.evmDenom = suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+
+	deployer := tests.GenerateAddress()
+	suite.deployer = deployer.Bytes()
+	suite.contract = crypto.CreateAddress(deployer, 2)
+
+	acc := &ethermint.EthAccount{
+		BaseAccount: authtypes.NewBaseAccountWithAddress(suite.contract.Bytes()),
+		CodeHash:    crypto.Keccak256Hash([]byte("code")).String(),
+	}
+	suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccount(suite.ctx, acc))
+}
+
+func (suite *KeeperTestSuite) fund(addr sdk.AccAddress, coins sdk.Coins) {
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, erc20types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, erc20types.ModuleName, addr, coins))
+}
+
+func (suite *KeeperTestSuite) register(amount int64) {
+	suite.fund(suite.deployer, sdk.NewCoins(sdk.NewInt64Coin(suite.evmDenom, amount)))
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	msg := types.NewMsgRegisterSponsorship(suite.contract, suite.deployer, []uint64{2}, sdk.NewInt64Coin(suite.evmDenom, amount))
+	_, err := suite.app.SponsorshipKeeper.RegisterSponsorship(ctx, msg)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) newMsg(signer keyring.Signer, from, to common.Address, gasPrice int64) *evmtypes.MsgEthereumTx {
+	chainID := suite.app.EvmKeeper.ChainID()
+	msg := evmtypes.NewTx(chainID, 0, &to, nil, 21000, big.NewInt(gasPrice), nil, nil, nil, nil)
+	msg.From = from.Hex()
+	suite.Require().NoError(msg.Sign(ethtypes.LatestSignerForChainID(chainID), signer))
+	return msg
+}
+
+func (suite *KeeperTestSuite) balance(addr sdk.AccAddress) int64 {
+	return suite.app.BankKeeper.GetBalance(suite.ctx, addr, suite.evmDenom).Amount.Int64()
+}
+
+func (suite *KeeperTestSuite) poolBalance() int64 {
+	sponsorship, found := suite.app.SponsorshipKeeper.GetSponsorship(suite.ctx, suite.contract)
+	suite.Require().True(found)
+	return sponsorship.Balance.Int64()
+}
+
+func (suite *KeeperTestSuite) TestRegisterSponsorship() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	suite.fund(suite.deployer, sdk.NewCoins(sdk.NewInt64Coin(suite.evmDenom, 1000)))
+
+	// wrong nonce
+	msg := types.NewMsgRegisterSponsorship(suite.contract, suite.deployer, []uint64{1}, sdk.NewInt64Coin(suite.evmDenom, 1000))
+	_, err := suite.app.SponsorshipKeeper.RegisterSponsorship(ctx, msg)
+	suite.Require().Error(err)
+
+	// not a deployed contract
+	notDeployed := crypto.CreateAddress(common.BytesToAddress(suite.deployer), 3)
+	msg = types.NewMsgRegisterSponsorship(notDeployed, suite.deployer, []uint64{3}, sdk.NewInt64Coin(suite.evmDenom, 1000))
+	_, err = suite.app.SponsorshipKeeper.RegisterSponsorship(ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrContractNotDeployed)
+
+	msg = types.NewMsgRegisterSponsorship(suite.contract, suite.deployer, []uint64{2}, sdk.NewInt64Coin(suite.evmDenom, 1000))
+	_, err = suite.app.SponsorshipKeeper.RegisterSponsorship(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(1000), suite.poolBalance())
+	suite.Require().Equal(int64(0), suite.balance(suite.deployer))
+
+	// already registered
+	_, err = suite.app.SponsorshipKeeper.RegisterSponsorship(ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrSponsorshipAlreadyRegistered)
+
+	res, err := suite.app.SponsorshipKeeper.Sponsorship(ctx, &types.QuerySponsorshipRequest{ContractAddress: suite.contract.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.deployer.String(), res.Sponsorship.OwnerAddress)
+
+	// only the owner can cancel the sponsorship
+	other := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	_, err = suite.app.SponsorshipKeeper.CancelSponsorship(ctx, types.NewMsgCancelSponsorship(suite.contract, other))
+	suite.Require().ErrorIs(err, types.ErrSponsorshipOwnerMismatch)
+
+	_, err = suite.app.SponsorshipKeeper.CancelSponsorship(ctx, types.NewMsgCancelSponsorship(suite.contract, suite.deployer))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.app.SponsorshipKeeper.IsSponsored(suite.ctx, suite.contract))
+	suite.Require().Equal(int64(1000), suite.balance(suite.deployer))
+}
+
+func (suite *KeeperTestSuite) TestSponsorTx() {
+	suite.register(100000)
+
+	decorator := sponsorship.NewSponsorshipDecorator(suite.app.SponsorshipKeeper)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	user, privKey := tests.NewAddrKey()
+	signer := tests.NewSigner(privKey)
+
+	// not a sponsored contract
+	_, err := decorator.AnteHandle(suite.ctx, &testTx{msgs: []sdk.Msg{suite.newMsg(signer, user, tests.GenerateAddress(), 1)}}, false, next)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(0), suite.balance(user.Bytes()))
+
+	// transactions with a fee granter are left to the fee grants
+	granter := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	_, err = decorator.AnteHandle(suite.ctx, &testTx{msgs: []sdk.Msg{suite.newMsg(signer, user, suite.contract, 1)}, granter: granter}, false, next)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(0), suite.balance(user.Bytes()))
+
+	msg := suite.newMsg(signer, user, suite.contract, 1)
+	_, err = decorator.AnteHandle(suite.ctx, &testTx{msgs: []sdk.Msg{msg}}, false, next)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(21000), suite.balance(user.Bytes()))
+	suite.Require().Equal(int64(79000), suite.poolBalance())
+	suite.Require().Equal(uint64(21000), suite.app.SponsorshipKeeper.GetBlockGas(suite.ctx))
+
+	res, err := suite.app.SponsorshipKeeper.UserGas(sdk.WrapSDKContext(suite.ctx), &types.QueryUserGasRequest{Address: user.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(21000), res.GasUsed)
+	suite.Require().Equal(types.DefaultParams().MaxGasPerUser-21000, res.GasRemaining)
+
+	// the fee of the unused gas is returned to the sponsor pool once
+	suite.Require().NoError(suite.app.SponsorshipKeeper.RefundSponsoredFees(suite.ctx, msg, 14000))
+	suite.Require().NoError(suite.app.SponsorshipKeeper.RefundSponsoredFees(suite.ctx, msg, 14000))
+	suite.Require().Equal(int64(14000), suite.balance(user.Bytes()))
+	suite.Require().Equal(int64(86000), suite.poolBalance())
+
+	// the user gas is reset at the end of the epoch
+	suite.app.SponsorshipKeeper.AfterEpochEnd(suite.ctx, "week", 1)
+	suite.Require().Equal(uint64(21000), suite.app.SponsorshipKeeper.GetUserGas(suite.ctx, user))
+	suite.app.SponsorshipKeeper.AfterEpochEnd(suite.ctx, types.DefaultParams().EpochIdentifier, 1)
+	suite.Require().Equal(uint64(0), suite.app.SponsorshipKeeper.GetUserGas(suite.ctx, user))
+}
+
+func (suite *KeeperTestSuite) TestSponsorTxLimits() {
+	suite.register(100000)
+
+	user, privKey := tests.NewAddrKey()
+	signer := tests.NewSigner(privKey)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		gasPrice int64
+	}{
+		{
+			"sponsorship disabled",
+			func() {
+				params := types.DefaultParams()
+				params.EnableSponsorship = false
+				suite.app.SponsorshipKeeper.SetParams(suite.ctx, params)
+			},
+			1,
+		},
+		{
+			"gas price above max gas price",
+			func() {
+				params := types.DefaultParams()
+				params.MaxGasPrice = sdk.NewInt(1)
+				suite.app.SponsorshipKeeper.SetParams(suite.ctx, params)
+			},
+			2,
+		},
+		{
+			"user gas limit exceeded",
+			func() {
+				suite.app.SponsorshipKeeper.SetUserGas(suite.ctx, user, types.DefaultParams().MaxGasPerUser-20000)
+			},
+			1,
+		},
+		{
+			"block gas limit exceeded",
+			func() {
+				suite.app.SponsorshipKeeper.SetBlockGas(suite.ctx, types.DefaultParams().MaxGasPerBlock-20000)
+			},
+			1,
+		},
+		{
+			"sponsor pool can't cover the fee",
+			func() {},
+			10,
+		},
+	}
+
+	for _, tc := range testCases {
+		ctx, _ := suite.ctx.CacheContext()
+		suite.ctx, ctx = ctx, suite.ctx
+
+		tc.malleate()
+
+		sponsored, err := suite.app.SponsorshipKeeper.SponsorTx(suite.ctx, user, suite.newMsg(signer, user, suite.contract, tc.gasPrice))
+		suite.Require().NoError(err, tc.name)
+		suite.Require().False(sponsored, tc.name)
+		suite.Require().Equal(int64(0), suite.balance(user.Bytes()), tc.name)
+		suite.Require().Equal(int64(100000), suite.poolBalance(), tc.name)
+
+		suite.ctx = ctx
+	}
+}
+
+type testTx struct {
+	msgs    []sdk.Msg
+	granter sdk.AccAddress
+}
+
+func (tx *testTx) GetMsgs() []sdk.Msg         { return tx.msgs }
+func (tx *testTx) ValidateBasic() error       { return nil }
+func (tx *testTx) GetGas() uint64             { return 21000 }
+func (tx *testTx) GetFee() sdk.Coins          { return nil }
+func (tx *testTx) FeePayer() sdk.AccAddress   { return nil }
+func (tx *testTx) FeeGranter() sdk.AccAddress { return tx.granter }
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tharsis/evmos/x/sponsorship/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// RegisterSponsorship sponsors the calls of a contract and deposits the
+// initial sponsor pool. The deployer proves the contract ownership by
+// providing the nonces of the address derivation path that leads to the
+// contract.
+func (k Keeper) RegisterSponsorship(goCtx context.Context, msg *types.MsgRegisterSponsorship) (*types.MsgRegisterSponsorshipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	contract := common.HexToAddress(msg.ContractAddress)
+	deployer, _ := sdk.AccAddressFromBech32(msg.DeployerAddress)
+
+	// derive the contract address from the deployer and the nonces, where
+	// every nonce but the first one belongs to a factory contract
+	derived := common.BytesToAddress(deployer)
+	for _, nonce := range msg.Nonces {
+		derived = crypto.CreateAddress(derived, nonce)
+	}
+
+	if derived != contract {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"contract %s was not deployed by %s with nonces %v", contract, msg.DeployerAddress, msg.Nonces,
+		)
+	}
+
+	if _, err := k.AddSponsorship(ctx, contract, deployer); err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.IsPositive() {
+		if err := k.DepositSponsorPool(ctx, contract, deployer, msg.Amount); err != nil {
+			return nil, sdkerrors.Wrap(err, "failed to fund the sponsor pool")
+		}
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeRegisterSponsorship,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.DeployerAddress),
+				sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			),
+		},
+	)
+
+	return &types.MsgRegisterSponsorshipResponse{}, nil
+}
+
+// FundSponsorship deposits funds in the sponsor pool of a contract
+func (k Keeper) FundSponsorship(goCtx context.Context, msg *types.MsgFundSponsorship) (*types.MsgFundSponsorshipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	contract := common.HexToAddress(msg.ContractAddress)
+	sender, _ := sdk.AccAddressFromBech32(msg.SenderAddress)
+
+	if err := k.DepositSponsorPool(ctx, contract, sender, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeFundSponsorship,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.SenderAddress),
+				sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			),
+		},
+	)
+
+	return &types.MsgFundSponsorshipResponse{}, nil
+}
+
+// CancelSponsorship removes the sponsorship of a contract registered by its
+// owner and returns the remaining sponsor pool to the owner
+func (k Keeper) CancelSponsorship(goCtx context.Context, msg *types.MsgCancelSponsorship) (*types.MsgCancelSponsorshipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	contract := common.HexToAddress(msg.ContractAddress)
+	owner, _ := sdk.AccAddressFromBech32(msg.OwnerAddress)
+
+	sponsorship, found := k.GetSponsorship(ctx, contract)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrSponsorshipNotFound, "contract %s", msg.ContractAddress)
+	}
+
+	// the sponsorships registered by governance have no owner
+	if !owner.Equals(sponsorship.GetOwnerAddr()) {
+		return nil, sdkerrors.Wrapf(
+			types.ErrSponsorshipOwnerMismatch, "%s is not the owner of the sponsorship of %s", msg.OwnerAddress, msg.ContractAddress,
+		)
+	}
+
+	if _, err := k.RemoveSponsorship(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCancelSponsorship,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress),
+				sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, sponsorship.Balance.String()),
+			),
+		},
+	)
+
+	return &types.MsgCancelSponsorshipResponse{}, nil
+}
@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/tharsis/evmos/x/sponsorship/types"
+)
+
+// GetEffectiveGasPrice returns the gas price deducted from the sender of an
+// Ethereum transaction by the Ethermint AnteHandler, i.e. the effective gas
+// tip for dynamic fee transactions, or the gas price otherwise.
+func (k Keeper) GetEffectiveGasPrice(ctx sdk.Context, txData evmtypes.TxData) *big.Int {
+	ethCfg := k.evmKeeper.GetParams(ctx).ChainConfig.EthereumConfig(k.evmKeeper.ChainID())
+
+	london := evmtypes.IsLondon(ethCfg, ctx.BlockHeight())
+	if !london || k.feeMarketKeeper.GetParams(ctx).NoBaseFee || txData.TxType() != ethtypes.DynamicFeeTxType {
+		return txData.GetGasPrice()
+	}
+
+	baseFee := k.feeMarketKeeper.GetBaseFee(ctx)
+	if baseFee == nil {
+		baseFee = common.Big0
+	}
+
+	gasFeeGap := new(big.Int).Sub(txData.GetGasFeeCap(), baseFee)
+	return math.BigMax(common.Big0, math.BigMin(txData.GetGasTipCap(), gasFeeGap))
+}
+
+// SponsorTx pays the fee of an Ethereum transaction calling a sponsored
+// contract from its sponsor pool, by sending it to the sender so that it can
+// be deducted by the Ethermint AnteHandler. It returns false, leaving the fee
+// to the sender, if the transaction exceeds the sponsorship limits or the
+// sponsor pool can't cover the fee.
+//
+// NOTE: the Ethermint AnteHandler checks that the sender balance covers the
+// gas fee cap of the dynamic fee transactions, which is higher than the
+// sponsored fee once the base fee is deducted.
+func (k Keeper) SponsorTx(ctx sdk.Context, sender common.Address, msgEthTx *evmtypes.MsgEthereumTx) (bool, error) {
+	params := k.GetParams(ctx)
+	if !params.EnableSponsorship {
+		return false, nil
+	}
+
+	tx := msgEthTx.AsTransaction()
+
+	// contract deployments can't be sponsored
+	contract := tx.To()
+	if contract == nil {
+		return false, nil
+	}
+
+	sponsorship, found := k.GetSponsorship(ctx, *contract)
+	if !found {
+		return false, nil
+	}
+
+	txData, err := evmtypes.UnpackTxData(msgEthTx.Data)
+	if err != nil {
+		return false, err
+	}
+
+	gasPrice := k.GetEffectiveGasPrice(ctx, txData)
+	if gasPrice.Cmp(params.MaxGasPrice.BigInt()) > 0 {
+		return false, nil
+	}
+
+	gasLimit := txData.GetGas()
+
+	userGas := k.GetUserGas(ctx, sender)
+	if userGas+gasLimit > params.MaxGasPerUser || userGas+gasLimit < userGas {
+		return false, nil
+	}
+
+	blockGas := k.GetBlockGas(ctx)
+	if blockGas+gasLimit > params.MaxGasPerBlock || blockGas+gasLimit < blockGas {
+		return false, nil
+	}
+
+	fee := sdk.NewIntFromBigInt(new(big.Int).Mul(new(big.Int).SetUint64(gasLimit), gasPrice))
+	if !fee.IsPositive() || fee.GT(sponsorship.Balance) {
+		return false, nil
+	}
+
+	evmDenom := k.evmKeeper.GetParams(ctx).EvmDenom
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender.Bytes(), sdk.Coins{sdk.NewCoin(evmDenom, fee)}); err != nil {
+		return false, err
+	}
+
+	sponsorship.Balance = sponsorship.Balance.Sub(fee)
+	k.SetSponsorship(ctx, sponsorship)
+	k.SetUserGas(ctx, sender, userGas+gasLimit)
+	k.SetBlockGas(ctx, blockGas+gasLimit)
+
+	sponsoredFee := types.SponsoredFee{
+		ContractAddress: sponsorship.ContractAddress,
+		SenderAddress:   sdk.AccAddress(sender.Bytes()).String(),
+		Fee:             fee,
+		GasLimit:        gasLimit,
+	}
+
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.GetSponsoredFeeKey(tx.Hash()), k.cdc.MustMarshal(&sponsoredFee))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSponsorTx,
+			sdk.NewAttribute(sdk.AttributeKeySender, sponsoredFee.SenderAddress),
+			sdk.NewAttribute(types.AttributeKeyContract, sponsoredFee.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+	)
+
+	return true, nil
+}
+
+// RefundSponsoredFees returns to the sponsor pool the fee of the gas that was
+// not used by the Ethereum transaction, which the EVM refunds to the sender.
+// It is a no-op if the fee of the transaction was not sponsored.
+func (k Keeper) RefundSponsoredFees(ctx sdk.Context, msgEthTx *evmtypes.MsgEthereumTx, gasUsed uint64) error {
+	store := ctx.TransientStore(k.transientKey)
+	key := types.GetSponsoredFeeKey(msgEthTx.AsTransaction().Hash())
+
+	bz := store.Get(key)
+	if bz == nil {
+		return nil
+	}
+
+	store.Delete(key)
+
+	var sponsoredFee types.SponsoredFee
+	k.cdc.MustUnmarshal(bz, &sponsoredFee)
+
+	if gasUsed >= sponsoredFee.GasLimit {
+		return nil
+	}
+
+	unusedGas := sdk.NewIntFromUint64(sponsoredFee.GasLimit - gasUsed)
+	refund := sponsoredFee.Fee.Mul(unusedGas).Quo(sdk.NewIntFromUint64(sponsoredFee.GasLimit))
+	if !refund.IsPositive() {
+		return nil
+	}
+
+	sender, err := sdk.AccAddressFromBech32(sponsoredFee.SenderAddress)
+	if err != nil {
+		return err
+	}
+
+	evmDenom := k.evmKeeper.GetParams(ctx).EvmDenom
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.Coins{sdk.NewCoin(evmDenom, refund)}); err != nil {
+		return err
+	}
+
+	// the sponsorship can't be cancelled during the transaction
+	sponsorship, found := k.GetSponsorship(ctx, common.HexToAddress(sponsoredFee.ContractAddress))
+	if !found {
+		return sdkerrors.Wrapf(types.ErrSponsorshipNotFound, "contract %s", sponsoredFee.ContractAddress)
+	}
+
+	sponsorship.Balance = sponsorship.Balance.Add(refund)
+	k.SetSponsorship(ctx, sponsorship)
+	return nil
+}
@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ethermint "github.com/tharsis/ethermint/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/tharsis/evmos/x/sponsorship/types"
+)
+
+// GetAllSponsorships returns all the sponsored contracts
+func (k Keeper) GetAllSponsorships(ctx sdk.Context) []types.Sponsorship {
+	sponsorships := []types.Sponsorship{}
+
+	k.IterateSponsorships(ctx, func(sponsorship types.Sponsorship) (stop bool) {
+		sponsorships = append(sponsorships, sponsorship)
+		return false
+	})
+
+	return sponsorships
+}
+
+// IterateSponsorships iterates over all the sponsored contracts and performs
+// a callback function
+func (k Keeper) IterateSponsorships(ctx sdk.Context, handlerFn func(sponsorship types.Sponsorship) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSponsorship)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var sponsorship types.Sponsorship
+		k.cdc.MustUnmarshal(iterator.Value(), &sponsorship)
+
+		if handlerFn(sponsorship) {
+			break
+		}
+	}
+}
+
+// GetSponsorship returns the sponsorship of the given contract
+func (k Keeper) GetSponsorship(ctx sdk.Context, contract common.Address) (types.Sponsorship, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSponsorship)
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.Sponsorship{}, false
+	}
+
+	var sponsorship types.Sponsorship
+	k.cdc.MustUnmarshal(bz, &sponsorship)
+	return sponsorship, true
+}
+
+// SetSponsorship stores the sponsorship of a contract
+func (k Keeper) SetSponsorship(ctx sdk.Context, sponsorship types.Sponsorship) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSponsorship)
+	bz := k.cdc.MustMarshal(&sponsorship)
+	store.Set(sponsorship.GetContractAddr().Bytes(), bz)
+}
+
+// DeleteSponsorship removes the sponsorship of a contract
+func (k Keeper) DeleteSponsorship(ctx sdk.Context, contract common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSponsorship)
+	store.Delete(contract.Bytes())
+}
+
+// IsSponsored checks if the calls of the contract are sponsored
+func (k Keeper) IsSponsored(ctx sdk.Context, contract common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSponsorship)
+	return store.Has(contract.Bytes())
+}
+
+// AddSponsorship sponsors the calls of a deployed contract with an empty
+// sponsor pool. The owner is empty for the sponsorships registered by
+// governance.
+func (k Keeper) AddSponsorship(ctx sdk.Context, contract common.Address, owner sdk.AccAddress) (types.Sponsorship, error) {
+	if !k.GetParams(ctx).EnableSponsorship {
+		return types.Sponsorship{}, sdkerrors.Wrap(types.ErrSponsorshipDisabled, "registration is currently disabled by governance")
+	}
+
+	if k.IsSponsored(ctx, contract) {
+		return types.Sponsorship{}, sdkerrors.Wrapf(types.ErrSponsorshipAlreadyRegistered, "contract %s", contract)
+	}
+
+	// check if the contract is deployed
+	acc := k.accountKeeper.GetAccount(ctx, contract.Bytes())
+	ethAccount, ok := acc.(*ethermint.EthAccount)
+	if !ok || ethAccount.GetCodeHash() == common.BytesToHash(evmtypes.EmptyCodeHash) {
+		return types.Sponsorship{}, sdkerrors.Wrapf(types.ErrContractNotDeployed, "contract %s", contract)
+	}
+
+	sponsorship := types.NewSponsorship(contract, owner)
+	k.SetSponsorship(ctx, sponsorship)
+	return sponsorship, nil
+}
+
+// DepositSponsorPool deposits an amount of the EVM denomination from the sender
+// in the sponsor pool of a contract
+func (k Keeper) DepositSponsorPool(ctx sdk.Context, contract common.Address, sender sdk.AccAddress, amount sdk.Coin) error {
+	sponsorship, err := k.validateFunds(ctx, contract, amount)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.Coins{amount}); err != nil {
+		return err
+	}
+
+	sponsorship.Balance = sponsorship.Balance.Add(amount.Amount)
+	k.SetSponsorship(ctx, sponsorship)
+	return nil
+}
+
+// DepositSponsorPoolFromCommunityPool deposits an amount of the EVM denomination
+// from the community pool in the sponsor pool of a contract
+func (k Keeper) DepositSponsorPoolFromCommunityPool(ctx sdk.Context, contract common.Address, amount sdk.Coin) error {
+	sponsorship, err := k.validateFunds(ctx, contract, amount)
+	if err != nil {
+		return err
+	}
+
+	if err := k.distrKeeper.DistributeFromFeePool(ctx, sdk.Coins{amount}, types.ModuleAddress); err != nil {
+		return err
+	}
+
+	sponsorship.Balance = sponsorship.Balance.Add(amount.Amount)
+	k.SetSponsorship(ctx, sponsorship)
+	return nil
+}
+
+// RemoveSponsorship removes the sponsorship of a contract and returns the
+// remaining sponsor pool to its owner, or to the community pool if it was
+// registered by governance
+func (k Keeper) RemoveSponsorship(ctx sdk.Context, contract common.Address) (types.Sponsorship, error) {
+	sponsorship, found := k.GetSponsorship(ctx, contract)
+	if !found {
+		return types.Sponsorship{}, sdkerrors.Wrapf(types.ErrSponsorshipNotFound, "contract %s", contract)
+	}
+
+	k.DeleteSponsorship(ctx, contract)
+
+	if !sponsorship.Balance.IsPositive() {
+		return sponsorship, nil
+	}
+
+	remaining := sdk.Coins{sdk.NewCoin(k.evmKeeper.GetParams(ctx).EvmDenom, sponsorship.Balance)}
+
+	owner := sponsorship.GetOwnerAddr()
+	if owner.Empty() {
+		return sponsorship, k.distrKeeper.FundCommunityPool(ctx, remaining, types.ModuleAddress)
+	}
+
+	return sponsorship, k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, remaining)
+}
+
+// validateFunds returns the sponsorship of a contract, checking that the
+// funds are in the EVM denomination
+func (k Keeper) validateFunds(ctx sdk.Context, contract common.Address, amount sdk.Coin) (types.Sponsorship, error) {
+	sponsorship, found := k.GetSponsorship(ctx, contract)
+	if !found {
+		return types.Sponsorship{}, sdkerrors.Wrapf(types.ErrSponsorshipNotFound, "contract %s", contract)
+	}
+
+	evmDenom := k.evmKeeper.GetParams(ctx).EvmDenom
+	if amount.Denom != evmDenom {
+		return types.Sponsorship{}, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidCoins, "sponsor pools only hold %s, got %s", evmDenom, amount.Denom,
+		)
+	}
+
+	return sponsorship, nil
+}
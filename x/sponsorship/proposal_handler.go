@@ -0,0 +1,71 @@
+package sponsorship
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/tharsis/evmos/x/sponsorship/keeper"
+	"github.com/tharsis/evmos/x/sponsorship/types"
+)
+
+// NewSponsorshipProposalHandler creates a governance handler to manage new
+// proposal types. It enables RegisterSponsorshipProposal to sponsor the calls
+// of a contract from the community pool and CancelSponsorshipProposal to
+// remove an existing sponsorship.
+func NewSponsorshipProposalHandler(k *keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.RegisterSponsorshipProposal:
+			return handleRegisterSponsorshipProposal(ctx, k, c)
+		case *types.CancelSponsorshipProposal:
+			return handleCancelSponsorshipProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
+
+func handleRegisterSponsorshipProposal(ctx sdk.Context, k *keeper.Keeper, p *types.RegisterSponsorshipProposal) error {
+	contract := common.HexToAddress(p.Contract)
+
+	if _, err := k.AddSponsorship(ctx, contract, nil); err != nil {
+		return err
+	}
+
+	if p.Amount.IsPositive() {
+		if err := k.DepositSponsorPoolFromCommunityPool(ctx, contract, p.Amount); err != nil {
+			return sdkerrors.Wrap(err, "failed to fund the sponsor pool")
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterSponsorship,
+			sdk.NewAttribute(types.AttributeKeyContract, p.Contract),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, p.Amount.String()),
+		),
+	)
+
+	return nil
+}
+
+func handleCancelSponsorshipProposal(ctx sdk.Context, k *keeper.Keeper, p *types.CancelSponsorshipProposal) error {
+	sponsorship, err := k.RemoveSponsorship(ctx, common.HexToAddress(p.Contract))
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelSponsorship,
+			sdk.NewAttribute(types.AttributeKeyContract, p.Contract),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sponsorship.Balance.String()),
+		),
+	)
+
+	return nil
+}
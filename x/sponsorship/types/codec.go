@@ -0,0 +1,53 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global sponsorship module codec. Note, the
+	// codec should ONLY be used in certain instances of tests and for JSON
+	// encoding.
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// AminoCdc is a amino codec created to support amino JSON compatible msgs.
+	AminoCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
+// RegisterInterfaces register implementations
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgRegisterSponsorship{},
+		&MsgFundSponsorship{},
+		&MsgCancelSponsorship{},
+	)
+
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&RegisterSponsorshipProposal{},
+		&CancelSponsorshipProposal{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// RegisterLegacyAminoCodec registers the necessary x/sponsorship interfaces and
+// concrete types on the provided LegacyAmino codec. These types are used for
+// Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgRegisterSponsorship{}, "sponsorship/MsgRegisterSponsorship", nil)
+	cdc.RegisterConcrete(&MsgFundSponsorship{}, "sponsorship/MsgFundSponsorship", nil)
+	cdc.RegisterConcrete(&MsgCancelSponsorship{}, "sponsorship/MsgCancelSponsorship", nil)
+}
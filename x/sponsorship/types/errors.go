@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// errors
+var (
+	ErrSponsorshipDisabled          = sdkerrors.Register(ModuleName, 2, "sponsorship is disabled")
+	ErrSponsorshipAlreadyRegistered = sdkerrors.Register(ModuleName, 3, "contract is already sponsored")
+	ErrSponsorshipNotFound          = sdkerrors.Register(ModuleName, 4, "contract is not sponsored")
+	ErrSponsorshipOwnerMismatch     = sdkerrors.Register(ModuleName, 5, "sender is not the owner of the sponsorship")
+	ErrContractNotDeployed          = sdkerrors.Register(ModuleName, 6, "contract is not deployed")
+)
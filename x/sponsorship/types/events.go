@@ -0,0 +1,13 @@
+package types
+
+// sponsorship events
+const (
+	EventTypeRegisterSponsorship = "register_sponsorship"
+	EventTypeFundSponsorship     = "fund_sponsorship"
+	EventTypeCancelSponsorship   = "cancel_sponsorship"
+	EventTypeSponsorTx           = "sponsor_tx"
+
+	AttributeKeyContract = "contract"
+	AttributeKeyOwner    = "owner"
+	AttributeKeyFee      = "fee"
+)
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, sponsorships []Sponsorship) GenesisState {
+	return GenesisState{
+		Params:       params,
+		Sponsorships: sponsorships,
+	}
+}
+
+// DefaultGenesisState returns the default sponsorship module genesis state
+// with no sponsored contracts.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenContracts := make(map[string]bool)
+
+	for _, sponsorship := range gs.Sponsorships {
+		contract := common.HexToAddress(sponsorship.ContractAddress).Hex()
+		if seenContracts[contract] {
+			return fmt.Errorf("contract duplicated on genesis '%s'", sponsorship.ContractAddress)
+		}
+
+		if err := sponsorship.Validate(); err != nil {
+			return err
+		}
+
+		seenContracts[contract] = true
+	}
+
+	return gs.Params.Validate()
+}
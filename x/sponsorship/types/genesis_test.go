@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/ethermint/tests"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	contract := tests.GenerateAddress()
+	owner := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	sponsorship := NewSponsorship(contract, owner)
+	govSponsorship := NewSponsorship(tests.GenerateAddress(), nil)
+	govSponsorship.Balance = sdk.NewInt(100)
+
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expPass  bool
+	}{
+		{"default", DefaultGenesisState(), true},
+		{"sponsorships", &GenesisState{Params: DefaultParams(), Sponsorships: []Sponsorship{sponsorship, govSponsorship}}, true},
+		{"duplicated sponsorship", &GenesisState{Params: DefaultParams(), Sponsorships: []Sponsorship{sponsorship, sponsorship}}, false},
+		{"invalid contract", &GenesisState{Params: DefaultParams(), Sponsorships: []Sponsorship{{ContractAddress: "0x", Balance: sdk.ZeroInt()}}}, false},
+		{"invalid owner", &GenesisState{Params: DefaultParams(), Sponsorships: []Sponsorship{{ContractAddress: contract.Hex(), OwnerAddress: "evmos1", Balance: sdk.ZeroInt()}}}, false},
+		{"negative balance", &GenesisState{Params: DefaultParams(), Sponsorships: []Sponsorship{{ContractAddress: contract.Hex(), Balance: sdk.NewInt(-1)}}}, false},
+		{"zero max gas per user", &GenesisState{Params: NewParams(true, "day", 0, 10, sdk.NewInt(1))}, false},
+		{"zero max gas per block", &GenesisState{Params: NewParams(true, "day", 10, 0, sdk.NewInt(1))}, false},
+		{"zero max gas price", &GenesisState{Params: NewParams(true, "day", 10, 10, sdk.ZeroInt())}, false},
+		{"empty epoch identifier", &GenesisState{Params: NewParams(true, "", 10, 10, sdk.NewInt(1))}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
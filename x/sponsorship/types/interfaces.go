@@ -0,0 +1,46 @@
+package types
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+	feemarkettypes "github.com/tharsis/ethermint/x/feemarket/types"
+)
+
+// AccountKeeper defines the expected interface needed to retrieve account info.
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+	GetModuleAddress(moduleName string) sdk.AccAddress
+}
+
+// BankKeeper defines the expected interface needed to move the funds of the
+// sponsor pools.
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	BlockedAddr(addr sdk.AccAddress) bool
+}
+
+// DistrKeeper defines the expected distribution keeper interface used to fund
+// the sponsor pools from the community pool and return them to it.
+type DistrKeeper interface {
+	DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// EVMKeeper defines the expected EVM keeper interface used to compute the fee
+// of the Ethereum transactions.
+type EVMKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+	ChainID() *big.Int
+}
+
+// FeeMarketKeeper defines the expected fee market keeper interface used to
+// compute the fee of the Ethereum transactions.
+type FeeMarketKeeper interface {
+	GetParams(ctx sdk.Context) feemarkettypes.Params
+	GetBaseFee(ctx sdk.Context) *big.Int
+}
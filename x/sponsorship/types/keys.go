@@ -0,0 +1,56 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// constants
+const (
+	// module name
+	ModuleName = "sponsorship"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// TransientKey is the key to access the sponsorship transient store, that
+	// is reset during the Commit phase.
+	TransientKey = "transient_" + ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// ModuleAddress is the address of the module account holding the sponsor pools
+var ModuleAddress = authtypes.NewModuleAddress(ModuleName)
+
+// prefix bytes for the sponsorship persistent store
+const (
+	prefixSponsorship = iota + 1
+	prefixUserGas
+)
+
+// prefix bytes for the sponsorship transient store
+const (
+	prefixTransientBlockGas = iota + 1
+	prefixTransientSponsoredFee
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixSponsorship = []byte{prefixSponsorship}
+	KeyPrefixUserGas     = []byte{prefixUserGas}
+)
+
+// Transient Store key prefixes
+var (
+	KeyPrefixTransientBlockGas     = []byte{prefixTransientBlockGas}
+	KeyPrefixTransientSponsoredFee = []byte{prefixTransientSponsoredFee}
+)
+
+// GetSponsoredFeeKey returns the transient store key of the fee sponsored for
+// the given Ethereum transaction
+func GetSponsoredFeeKey(txHash common.Hash) []byte {
+	return append(KeyPrefixTransientSponsoredFee, txHash.Bytes()...)
+}
@@ -0,0 +1,168 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ethermint "github.com/tharsis/ethermint/types"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterSponsorship{}
+	_ sdk.Msg = &MsgFundSponsorship{}
+	_ sdk.Msg = &MsgCancelSponsorship{}
+)
+
+const (
+	TypeMsgRegisterSponsorship = "register_sponsorship"
+	TypeMsgFundSponsorship     = "fund_sponsorship"
+	TypeMsgCancelSponsorship   = "cancel_sponsorship"
+)
+
+// NewMsgRegisterSponsorship creates a new instance of MsgRegisterSponsorship
+func NewMsgRegisterSponsorship(
+	contract common.Address,
+	deployer sdk.AccAddress,
+	nonces []uint64,
+	amount sdk.Coin,
+) *MsgRegisterSponsorship { // nolint: interfacer
+	return &MsgRegisterSponsorship{
+		ContractAddress: contract.String(),
+		DeployerAddress: deployer.String(),
+		Nonces:          nonces,
+		Amount:          amount,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRegisterSponsorship) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRegisterSponsorship) Type() string { return TypeMsgRegisterSponsorship }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRegisterSponsorship) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DeployerAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid deployer address")
+	}
+
+	if err := ethermint.ValidateAddress(msg.ContractAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid contract address")
+	}
+
+	if len(msg.Nonces) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "nonces cannot be empty")
+	}
+
+	if err := msg.Amount.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgRegisterSponsorship) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRegisterSponsorship) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DeployerAddress)
+	if err != nil {
+		return nil
+	}
+
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgFundSponsorship creates a new instance of MsgFundSponsorship
+func NewMsgFundSponsorship(contract common.Address, sender sdk.AccAddress, amount sdk.Coin) *MsgFundSponsorship { // nolint: interfacer
+	return &MsgFundSponsorship{
+		ContractAddress: contract.String(),
+		SenderAddress:   sender.String(),
+		Amount:          amount,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgFundSponsorship) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgFundSponsorship) Type() string { return TypeMsgFundSponsorship }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgFundSponsorship) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.SenderAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+
+	if err := ethermint.ValidateAddress(msg.ContractAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid contract address")
+	}
+
+	if err := msg.Amount.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	if !msg.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amount must be positive: %s", msg.Amount)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgFundSponsorship) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgFundSponsorship) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.SenderAddress)
+	if err != nil {
+		return nil
+	}
+
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgCancelSponsorship creates a new instance of MsgCancelSponsorship
+func NewMsgCancelSponsorship(contract common.Address, owner sdk.AccAddress) *MsgCancelSponsorship { // nolint: interfacer
+	return &MsgCancelSponsorship{
+		ContractAddress: contract.String(),
+		OwnerAddress:    owner.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgCancelSponsorship) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCancelSponsorship) Type() string { return TypeMsgCancelSponsorship }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCancelSponsorship) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid owner address")
+	}
+
+	return ethermint.ValidateAddress(msg.ContractAddress)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgCancelSponsorship) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelSponsorship) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		return nil
+	}
+
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	epochstypes "github.com/tharsis/evmos/x/epochs/types"
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// Parameter store key
+var (
+	ParamStoreKeyEnableSponsorship = []byte("EnableSponsorship")
+	ParamStoreKeyEpochIdentifier   = []byte("EpochIdentifier")
+	ParamStoreKeyMaxGasPerUser     = []byte("MaxGasPerUser")
+	ParamStoreKeyMaxGasPerBlock    = []byte("MaxGasPerBlock")
+	ParamStoreKeyMaxGasPrice       = []byte("MaxGasPrice")
+)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(
+	enableSponsorship bool,
+	epochIdentifier string,
+	maxGasPerUser,
+	maxGasPerBlock uint64,
+	maxGasPrice sdk.Int,
+) Params {
+	return Params{
+		EnableSponsorship: enableSponsorship,
+		EpochIdentifier:   epochIdentifier,
+		MaxGasPerUser:     maxGasPerUser,
+		MaxGasPerBlock:    maxGasPerBlock,
+		MaxGasPrice:       maxGasPrice,
+	}
+}
+
+// DefaultParams returns default sponsorship module parameters
+func DefaultParams() Params {
+	return Params{
+		EnableSponsorship: true,
+		EpochIdentifier:   epochstypes.DayEpochID,
+		MaxGasPerUser:     1_000_000,
+		MaxGasPerBlock:    10_000_000,
+		MaxGasPrice:       sdk.NewInt(100_000_000_000),
+	}
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyEnableSponsorship, &p.EnableSponsorship, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyEpochIdentifier, &p.EpochIdentifier, epochstypes.ValidateEpochIdentifierInterface),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxGasPerUser, &p.MaxGasPerUser, validateGas),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxGasPerBlock, &p.MaxGasPerBlock, validateGas),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxGasPrice, &p.MaxGasPrice, validateGasPrice),
+	}
+}
+
+// Validate performs a stateless validation of the sponsorship module
+// parameters.
+func (p Params) Validate() error {
+	if err := validateBool(p.EnableSponsorship); err != nil {
+		return err
+	}
+
+	if err := epochstypes.ValidateEpochIdentifierString(p.EpochIdentifier); err != nil {
+		return err
+	}
+
+	if err := validateGas(p.MaxGasPerUser); err != nil {
+		return err
+	}
+
+	if err := validateGas(p.MaxGasPerBlock); err != nil {
+		return err
+	}
+
+	return validateGasPrice(p.MaxGasPrice)
+}
+
+func validateBool(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateGas(i interface{}) error {
+	gas, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if gas == 0 {
+		return errors.New("gas limit cannot be 0")
+	}
+
+	return nil
+}
+
+func validateGasPrice(i interface{}) error {
+	gasPrice, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if gasPrice.IsNil() || !gasPrice.IsPositive() {
+		return fmt.Errorf("max gas price must be positive: %s", gasPrice)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	ethermint "github.com/tharsis/ethermint/types"
+)
+
+// constants
+const (
+	ProposalTypeRegisterSponsorship string = "RegisterSponsorship"
+	ProposalTypeCancelSponsorship   string = "CancelSponsorship"
+)
+
+// Implements Proposal Interface
+var (
+	_ govtypes.Content = &RegisterSponsorshipProposal{}
+	_ govtypes.Content = &CancelSponsorshipProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeRegisterSponsorship)
+	govtypes.RegisterProposalType(ProposalTypeCancelSponsorship)
+	govtypes.RegisterProposalTypeCodec(&RegisterSponsorshipProposal{}, "sponsorship/RegisterSponsorshipProposal")
+	govtypes.RegisterProposalTypeCodec(&CancelSponsorshipProposal{}, "sponsorship/CancelSponsorshipProposal")
+}
+
+// NewRegisterSponsorshipProposal returns new instance of RegisterSponsorshipProposal
+func NewRegisterSponsorshipProposal(title, description, contract string, amount sdk.Coin) govtypes.Content {
+	return &RegisterSponsorshipProposal{
+		Title:       title,
+		Description: description,
+		Contract:    contract,
+		Amount:      amount,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*RegisterSponsorshipProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*RegisterSponsorshipProposal) ProposalType() string {
+	return ProposalTypeRegisterSponsorship
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (rsp *RegisterSponsorshipProposal) ValidateBasic() error {
+	if err := ethermint.ValidateAddress(rsp.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract address")
+	}
+
+	if err := rsp.Amount.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	return govtypes.ValidateAbstract(rsp)
+}
+
+// NewCancelSponsorshipProposal returns new instance of CancelSponsorshipProposal
+func NewCancelSponsorshipProposal(title, description, contract string) govtypes.Content {
+	return &CancelSponsorshipProposal{
+		Title:       title,
+		Description: description,
+		Contract:    contract,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*CancelSponsorshipProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*CancelSponsorshipProposal) ProposalType() string {
+	return ProposalTypeCancelSponsorship
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (csp *CancelSponsorshipProposal) ValidateBasic() error {
+	if err := ethermint.ValidateAddress(csp.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract address")
+	}
+
+	return govtypes.ValidateAbstract(csp)
+}
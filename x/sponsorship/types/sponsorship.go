@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ethermint "github.com/tharsis/ethermint/types"
+)
+
+// NewSponsorship returns an instance of Sponsorship with an empty sponsor
+// pool. The owner is empty for the sponsorships registered by governance.
+func NewSponsorship(contract common.Address, owner sdk.AccAddress) Sponsorship { // nolint: interfacer
+	ownerAddress := ""
+	if !owner.Empty() {
+		ownerAddress = owner.String()
+	}
+
+	return Sponsorship{
+		ContractAddress: contract.String(),
+		OwnerAddress:    ownerAddress,
+		Balance:         sdk.ZeroInt(),
+	}
+}
+
+// GetContractAddr returns the contract address
+func (s Sponsorship) GetContractAddr() common.Address {
+	return common.HexToAddress(s.ContractAddress)
+}
+
+// GetOwnerAddr returns the owner address, which is empty for the sponsorships
+// registered by governance
+func (s Sponsorship) GetOwnerAddr() sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(s.OwnerAddress)
+	return addr
+}
+
+// Validate performs a stateless validation of a Sponsorship
+func (s Sponsorship) Validate() error {
+	if err := ethermint.ValidateAddress(s.ContractAddress); err != nil {
+		return err
+	}
+
+	if s.OwnerAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(s.OwnerAddress); err != nil {
+			return sdkerrors.Wrap(err, "invalid owner address")
+		}
+	}
+
+	if s.Balance.IsNil() || s.Balance.IsNegative() {
+		return fmt.Errorf("invalid sponsor pool balance %s", s.Balance)
+	}
+
+	return nil
+}
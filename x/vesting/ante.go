@@ -0,0 +1,89 @@
+package vesting
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/tharsis/evmos/x/vesting/types"
+)
+
+// DelegationDecorator rejects the delegations of unvested tokens from clawback
+// vesting accounts, so that the unvested tokens always remain in the account
+// balance and can be clawed back by the funder. Vested tokens can be delegated
+// even if they are still locked.
+type DelegationDecorator struct {
+	ak types.AccountKeeper
+}
+
+// NewDelegationDecorator creates a new DelegationDecorator
+func NewDelegationDecorator(ak types.AccountKeeper) DelegationDecorator {
+	return DelegationDecorator{
+		ak: ak,
+	}
+}
+
+// AnteHandle checks the delegation messages, including the ones executed
+// through authz, against the vested tokens of the delegator.
+func (dd DelegationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := dd.validateMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (dd DelegationDecorator) validateMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *authz.MsgExec:
+			execMsgs, err := msg.GetMessages()
+			if err != nil {
+				return err
+			}
+
+			if err := dd.validateMsgs(ctx, execMsgs); err != nil {
+				return err
+			}
+		case *stakingtypes.MsgDelegate:
+			if err := dd.validateDelegation(ctx, msg); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (dd DelegationDecorator) validateDelegation(ctx sdk.Context, msg *stakingtypes.MsgDelegate) error {
+	delegator, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+
+	acc := dd.ak.GetAccount(ctx, delegator)
+	if acc == nil {
+		return nil
+	}
+
+	vestingAcc, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil
+	}
+
+	vested := vestingAcc.GetVestedOnly(ctx.BlockTime()).AmountOf(msg.Amount.Denom)
+	delegated := vestingAcc.DelegatedFree.AmountOf(msg.Amount.Denom).
+		Add(vestingAcc.DelegatedVesting.AmountOf(msg.Amount.Denom))
+
+	available := sdk.MaxInt(vested.Sub(delegated), sdk.ZeroInt())
+	if msg.Amount.Amount.GT(available) {
+		return sdkerrors.Wrapf(
+			types.ErrVestingDelegation,
+			"delegation amount %s exceeds the vested and undelegated amount %s%s",
+			msg.Amount, available, msg.Amount.Denom,
+		)
+	}
+
+	return nil
+}
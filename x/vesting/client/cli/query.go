@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/tharsis/evmos/x/vesting/types"
+)
+
+// GetQueryCmd returns the parent command for all vesting CLI query commands
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the vesting module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetBalancesCmd(),
+	)
+	return cmd
+}
+
+// GetBalancesCmd queries the locked, unvested and vested tokens of a clawback
+// vesting account
+func GetBalancesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balances [address]",
+		Short: "Gets the locked, unvested and vested tokens of a vesting account",
+		Long:  "Gets the locked, unvested and vested tokens of a clawback vesting account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBalancesRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.Balances(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
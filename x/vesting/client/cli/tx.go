@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/x/vesting/types"
+)
+
+// Transaction command flags
+const (
+	FlagLockup  = "lockup"
+	FlagVesting = "vesting"
+)
+
+// NewTxCmd returns a root CLI command handler for vesting transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "vesting subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewMsgCreateClawbackVestingAccountCmd(),
+		NewMsgClawbackCmd(),
+	)
+	return txCmd
+}
+
+// NewMsgCreateClawbackVestingAccountCmd returns a CLI command handler for
+// creating a clawback vesting account funded by the sender
+func NewMsgCreateClawbackVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-vesting-account [to_address]",
+		Short: "Create a new vesting account funded by the sender, who can clawback the unvested tokens.",
+		Long: `Create a new vesting account funded by the sender, who can clawback the unvested tokens.
+The lockup and vesting schedules are read from JSON files with the following format:
+{
+  "start_time": 1625204910,
+  "periods": [
+    { "coins": "10000000000aphoton", "length_seconds": 2592000 },
+    { "coins": "10000000000aphoton", "length_seconds": 2592000 }
+  ]
+}
+Both schedules must have the same start time and total amount. If one of them is omitted, it defaults to an instant release at the start time.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			lockupFile, _ := cmd.Flags().GetString(FlagLockup)
+			vestingFile, _ := cmd.Flags().GetString(FlagVesting)
+
+			startTime, lockupPeriods, vestingPeriods, err := ReadSchedules(lockupFile, vestingFile)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateClawbackVestingAccount(
+				cliCtx.GetFromAddress(), to, time.Unix(startTime, 0), lockupPeriods, vestingPeriods,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagLockup, "", "path to the file containing the lockup schedule")
+	cmd.Flags().String(FlagVesting, "", "path to the file containing the vesting schedule")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMsgClawbackCmd returns a CLI command handler for clawing back the
+// unvested tokens of a clawback vesting account funded by the sender
+func NewMsgClawbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [address] [dest_address]",
+		Short: "Transfer the unvested tokens of a vesting account funded by the sender. When the destination address is omitted, the tokens are sent to the sender.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var dest sdk.AccAddress
+			if len(args) == 2 {
+				dest, err = sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return fmt.Errorf("invalid destination address: %w", err)
+				}
+			}
+
+			msg := types.NewMsgClawback(cliCtx.GetFromAddress(), addr, dest)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
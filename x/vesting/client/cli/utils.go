@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// InputPeriod defines a lockup or vesting period as read from a schedule file
+type InputPeriod struct {
+	Coins  string `json:"coins"`
+	Length int64  `json:"length_seconds"`
+}
+
+// VestingData defines the JSON format of a lockup or vesting schedule file,
+// e.g.
+//
+//	{
+//	  "start_time": 1625204910,
+//	  "periods": [
+//	    { "coins": "10000000000aphoton", "length_seconds": 2592000 },
+//	    { "coins": "10000000000aphoton", "length_seconds": 2592000 }
+//	  ]
+//	}
+type VestingData struct {
+	StartTime int64         `json:"start_time"`
+	Periods   []InputPeriod `json:"periods"`
+}
+
+// ReadScheduleFile reads the start time and the periods of a lockup or vesting
+// schedule from the given JSON file.
+func ReadScheduleFile(path string) (int64, sdkvesting.Periods, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var data VestingData
+	if err := json.Unmarshal(contents, &data); err != nil {
+		return 0, nil, fmt.Errorf("failed to parse schedule file %s: %w", path, err)
+	}
+
+	startTime := data.StartTime
+	if startTime < 0 {
+		return 0, nil, fmt.Errorf("invalid start time %d in schedule file %s", startTime, path)
+	}
+
+	periods, err := ParseInputPeriods(data.Periods)
+	if err != nil {
+		return 0, nil, fmt.Errorf("invalid schedule file %s: %w", path, err)
+	}
+
+	return startTime, periods, nil
+}
+
+// ParseInputPeriods converts the periods of a lockup or vesting schedule read
+// from a file.
+func ParseInputPeriods(inputPeriods []InputPeriod) (sdkvesting.Periods, error) {
+	periods := make(sdkvesting.Periods, len(inputPeriods))
+	for i, p := range inputPeriods {
+		amount, err := sdk.ParseCoinsNormalized(p.Coins)
+		if err != nil {
+			return nil, fmt.Errorf("invalid coins in period #%d: %w", i, err)
+		}
+
+		if p.Length < 0 {
+			return nil, fmt.Errorf("invalid length in period #%d: %d", i, p.Length)
+		}
+
+		periods[i] = sdkvesting.Period{Length: p.Length, Amount: amount}
+	}
+
+	return periods, nil
+}
+
+// ReadSchedules reads the lockup and vesting schedule files, any of which may
+// be omitted, and returns their common start time.
+func ReadSchedules(lockupFile, vestingFile string) (startTime int64, lockupPeriods, vestingPeriods sdkvesting.Periods, err error) {
+	if lockupFile == "" && vestingFile == "" {
+		return 0, nil, nil, fmt.Errorf("at least one of the lockup and vesting schedules must be provided")
+	}
+
+	var lockupStart, vestingStart int64
+
+	if lockupFile != "" {
+		lockupStart, lockupPeriods, err = ReadScheduleFile(lockupFile)
+		if err != nil {
+			return 0, nil, nil, err
+		}
+		startTime = lockupStart
+	}
+
+	if vestingFile != "" {
+		vestingStart, vestingPeriods, err = ReadScheduleFile(vestingFile)
+		if err != nil {
+			return 0, nil, nil, err
+		}
+		startTime = vestingStart
+	}
+
+	if lockupFile != "" && vestingFile != "" && lockupStart != vestingStart {
+		return 0, nil, nil, fmt.Errorf("lockup start time %d and vesting start time %d must be equal", lockupStart, vestingStart)
+	}
+
+	return startTime, lockupPeriods, vestingPeriods, nil
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	ethermint "github.com/tharsis/ethermint/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/tharsis/evmos/x/vesting/types"
+)
+
+var _ evmtypes.AccountKeeper = EVMAccountKeeper{}
+
+// EVMAccountKeeper wraps the account keeper of the EVM keeper so that the EVM
+// state transitions apply to clawback vesting accounts. The EVM keeper only
+// reads and writes the nonce and the code hash of EthAccounts, so a clawback
+// vesting account is returned as an EthAccount holding its base account and
+// code hash, which are written back to the vesting account when it is set.
+type EVMAccountKeeper struct {
+	evmtypes.AccountKeeper
+}
+
+// NewEVMAccountKeeper creates a new EVMAccountKeeper.
+func NewEVMAccountKeeper(ak evmtypes.AccountKeeper) EVMAccountKeeper {
+	return EVMAccountKeeper{AccountKeeper: ak}
+}
+
+// GetAccount returns the account at the given address, as an EthAccount if it
+// is a clawback vesting account.
+func (ak EVMAccountKeeper) GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI {
+	account := ak.AccountKeeper.GetAccount(ctx, addr)
+	vestingAcc, ok := account.(*types.ClawbackVestingAccount)
+	if !ok {
+		return account
+	}
+
+	baseAcc := *vestingAcc.BaseAccount
+	return &ethermint.EthAccount{
+		BaseAccount: &baseAcc,
+		CodeHash:    vestingAcc.GetCodeHash().Hex(),
+	}
+}
+
+// SetAccount sets the account, writing the base account and the code hash of
+// an EthAccount to the clawback vesting account stored at its address, if any.
+func (ak EVMAccountKeeper) SetAccount(ctx sdk.Context, account authtypes.AccountI) {
+	ethAccount, ok := account.(*ethermint.EthAccount)
+	if !ok {
+		ak.AccountKeeper.SetAccount(ctx, account)
+		return
+	}
+
+	vestingAcc, ok := ak.AccountKeeper.GetAccount(ctx, account.GetAddress()).(*types.ClawbackVestingAccount)
+	if !ok {
+		ak.AccountKeeper.SetAccount(ctx, account)
+		return
+	}
+
+	// the account number is kept, as the EVM keeper sets a new account when it
+	// deploys a contract to an existing address
+	baseAcc := *ethAccount.BaseAccount
+	baseAcc.AccountNumber = vestingAcc.AccountNumber
+	vestingAcc.BaseAccount = &baseAcc
+	vestingAcc.SetCodeHash(ethAccount.GetCodeHash())
+	ak.AccountKeeper.SetAccount(ctx, vestingAcc)
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/x/vesting/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Balances returns the locked, unvested and vested tokens of a clawback
+// vesting account
+func (k Keeper) Balances(c context.Context, req *types.QueryBalancesRequest) (*types.QueryBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	addr, err := sdk.AccAddressFromBech32(strings.TrimSpace(req.Address))
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid address: %s", err.Error(),
+		)
+	}
+
+	acc := k.accountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, status.Errorf(codes.NotFound, "account for address '%s'", req.Address)
+	}
+
+	vestingAcc, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "account at address '%s' is not a clawback vesting account", req.Address)
+	}
+
+	blockTime := ctx.BlockTime()
+
+	return &types.QueryBalancesResponse{
+		Locked:   vestingAcc.LockedCoins(blockTime),
+		Unvested: vestingAcc.GetUnvestedOnly(blockTime),
+		Vested:   vestingAcc.GetVestedOnly(blockTime),
+	}, nil
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/tharsis/evmos/x/vesting/types"
+)
+
+// Keeper of this module creates and clawbacks the clawback vesting accounts.
+type Keeper struct {
+	cdc codec.BinaryCodec
+
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+}
+
+// NewKeeper creates new instances of the vesting Keeper
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+) Keeper {
+	return Keeper{
+		cdc:           cdc,
+		accountKeeper: ak,
+		bankKeeper:    bk,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
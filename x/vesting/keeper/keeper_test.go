@@ -0,0 +1,198 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/tharsis/ethermint/tests"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/tharsis/evmos/app"
+	erc20types "github.com/tharsis/evmos/x/erc20/types"
+	"github.com/tharsis/evmos/x/vesting"
+	"github.com/tharsis/evmos/x/vesting/types"
+)
+
+const denom = "aevmos"
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx     sdk.Context
+	app     *app.Evmos
+	funder  sdk.AccAddress
+	grantee sdk.AccAddress
+	start   time.Time
+	lockup  sdkvesting.Periods
+	vesting sdkvesting.Periods
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func coins(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.Setup(false, nil)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: "evmos_9000-1",
+		Time:    time.Now().UTC(),
+	})
+
+	suite.funder = tests.GenerateAddress().Bytes()
+	suite.grantee = tests.GenerateAddress().Bytes()
+
+	amount := coins(1000)
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, erc20types.ModuleName, amount))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, erc20types.ModuleName, suite.funder, amount))
+
+	// locked for 4 hours, vested by quarters every hour
+	suite.start = suite.ctx.BlockTime()
+	hour := int64(time.Hour.Seconds())
+	suite.lockup = sdkvesting.Periods{{Length: 4 * hour, Amount: coins(400)}}
+	suite.vesting = sdkvesting.Periods{
+		{Length: hour, Amount: coins(100)},
+		{Length: hour, Amount: coins(100)},
+		{Length: hour, Amount: coins(100)},
+		{Length: hour, Amount: coins(100)},
+	}
+}
+
+func (suite *KeeperTestSuite) createVestingAccount() {
+	msg := types.NewMsgCreateClawbackVestingAccount(suite.funder, suite.grantee, suite.start, suite.lockup, suite.vesting)
+	_, err := suite.app.VestingKeeper.CreateClawbackVestingAccount(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestCreateClawbackVestingAccount() {
+	suite.createVestingAccount()
+
+	acc := suite.app.AccountKeeper.GetAccount(suite.ctx, suite.grantee)
+	vestingAcc, ok := acc.(*types.ClawbackVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(suite.funder, vestingAcc.GetFunder())
+	suite.Require().Equal(coins(400), vestingAcc.OriginalVesting)
+
+	suite.Require().Equal(coins(600), suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.funder))
+	suite.Require().Equal(coins(400), suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.grantee))
+	suite.Require().True(suite.app.BankKeeper.SpendableCoins(suite.ctx, suite.grantee).IsZero())
+
+	// the account can't be created twice
+	msg := types.NewMsgCreateClawbackVestingAccount(suite.funder, suite.grantee, suite.start, suite.lockup, suite.vesting)
+	_, err := suite.app.VestingKeeper.CreateClawbackVestingAccount(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().Error(err)
+
+	// vested tokens become spendable once unlocked
+	ctx := suite.ctx.WithBlockTime(suite.start.Add(4 * time.Hour))
+	suite.Require().Equal(coins(400), suite.app.BankKeeper.SpendableCoins(ctx, suite.grantee))
+
+	res, err := suite.app.VestingKeeper.Balances(sdk.WrapSDKContext(suite.ctx.WithBlockTime(suite.start.Add(time.Hour))), &types.QueryBalancesRequest{Address: suite.grantee.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins(400), res.Locked)
+	suite.Require().Equal(coins(300), res.Unvested)
+	suite.Require().Equal(coins(100), res.Vested)
+}
+
+func (suite *KeeperTestSuite) TestClawback() {
+	suite.createVestingAccount()
+	ctx := suite.ctx.WithBlockTime(suite.start.Add(2 * time.Hour))
+	dest := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	_, err := suite.app.VestingKeeper.Clawback(sdk.WrapSDKContext(ctx), types.NewMsgClawback(suite.grantee, suite.grantee, nil))
+	suite.Require().ErrorIs(err, types.ErrFunderMismatch)
+
+	_, err = suite.app.VestingKeeper.Clawback(sdk.WrapSDKContext(ctx), types.NewMsgClawback(suite.funder, suite.funder, nil))
+	suite.Require().ErrorIs(err, types.ErrNotClawbackAccount)
+
+	_, err = suite.app.VestingKeeper.Clawback(sdk.WrapSDKContext(ctx), types.NewMsgClawback(suite.funder, suite.grantee, dest))
+	suite.Require().NoError(err)
+
+	// the vested half remains locked until the end of the lockup
+	suite.Require().Equal(coins(200), suite.app.BankKeeper.GetAllBalances(ctx, dest))
+	suite.Require().Equal(coins(200), suite.app.BankKeeper.GetAllBalances(ctx, suite.grantee))
+	suite.Require().True(suite.app.BankKeeper.SpendableCoins(ctx, suite.grantee).IsZero())
+
+	ctx = ctx.WithBlockTime(suite.start.Add(4 * time.Hour))
+	suite.Require().Equal(coins(200), suite.app.BankKeeper.SpendableCoins(ctx, suite.grantee))
+
+	acc := suite.app.AccountKeeper.GetAccount(ctx, suite.grantee).(*types.ClawbackVestingAccount)
+	suite.Require().NoError(acc.Validate())
+}
+
+func (suite *KeeperTestSuite) TestDeployContractToVestingAccount() {
+	suite.createVestingAccount()
+	accNumber := suite.app.AccountKeeper.GetAccount(suite.ctx, suite.grantee).GetAccountNumber()
+
+	// a contract is created with CREATE2 at the address of the vesting account,
+	// which hasn't sent any transaction
+	addr := common.BytesToAddress(suite.grantee)
+	code := []byte{0x60, 0x00}
+	suite.app.EvmKeeper.WithContext(suite.ctx)
+	suite.Require().Zero(suite.app.EvmKeeper.GetNonce(addr))
+	suite.Require().Equal(common.BytesToHash(evmtypes.EmptyCodeHash), suite.app.EvmKeeper.GetCodeHash(addr))
+
+	suite.app.EvmKeeper.CreateAccount(addr)
+	suite.app.EvmKeeper.SetNonce(addr, 1)
+	suite.app.EvmKeeper.SetCode(addr, code)
+	suite.Require().False(suite.app.EvmKeeper.HasStateError())
+
+	suite.Require().Equal(code, suite.app.EvmKeeper.GetCode(addr))
+	suite.Require().Equal(crypto.Keccak256Hash(code), suite.app.EvmKeeper.GetCodeHash(addr))
+
+	// the vesting schedule is kept
+	vestingAcc, ok := suite.app.AccountKeeper.GetAccount(suite.ctx, suite.grantee).(*types.ClawbackVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(crypto.Keccak256Hash(code), vestingAcc.GetCodeHash())
+	suite.Require().Equal(uint64(1), vestingAcc.GetSequence())
+	suite.Require().Equal(accNumber, vestingAcc.GetAccountNumber())
+	suite.Require().Equal(coins(400), vestingAcc.OriginalVesting)
+	suite.Require().Equal(suite.funder, vestingAcc.GetFunder())
+}
+
+func (suite *KeeperTestSuite) TestDelegationDecorator() {
+	suite.createVestingAccount()
+	ctx := suite.ctx.WithBlockTime(suite.start.Add(time.Hour))
+	decorator := vesting.NewDelegationDecorator(suite.app.AccountKeeper)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	valAddr := sdk.ValAddress(tests.GenerateAddress().Bytes())
+	tx := func(amount int64) sdk.Tx {
+		return &testTx{msgs: []sdk.Msg{
+			stakingtypes.NewMsgDelegate(suite.grantee, valAddr, sdk.NewInt64Coin(denom, amount)),
+		}}
+	}
+
+	_, err := decorator.AnteHandle(ctx, tx(100), false, next)
+	suite.Require().NoError(err, "vested tokens can be delegated while locked")
+
+	_, err = decorator.AnteHandle(ctx, tx(101), false, next)
+	suite.Require().ErrorIs(err, types.ErrVestingDelegation)
+
+	// other accounts are not restricted
+	other := &testTx{msgs: []sdk.Msg{
+		stakingtypes.NewMsgDelegate(suite.funder, valAddr, sdk.NewInt64Coin(denom, 600)),
+	}}
+	_, err = decorator.AnteHandle(ctx, other, false, next)
+	suite.Require().NoError(err)
+}
+
+type testTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx *testTx) GetMsgs() []sdk.Msg   { return tx.msgs }
+func (tx *testTx) ValidateBasic() error { return nil }
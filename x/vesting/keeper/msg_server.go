@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/tharsis/evmos/x/vesting/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// CreateClawbackVestingAccount creates a new ClawbackVestingAccount funded by
+// the message sender, who is allowed to clawback the unvested tokens.
+func (k Keeper) CreateClawbackVestingAccount(
+	goCtx context.Context,
+	msg *types.MsgCreateClawbackVestingAccount,
+) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	from, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	to, _ := sdk.AccAddressFromBech32(msg.ToAddress)
+
+	if k.bankKeeper.BlockedAddr(to) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	if acc := k.accountKeeper.GetAccount(ctx, to); acc != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	}
+
+	lockupPeriods, vestingPeriods := types.DefaultSchedules(msg.LockupPeriods, msg.VestingPeriods)
+
+	baseAcc := authtypes.NewBaseAccountWithAddress(to)
+	vestingAcc := types.NewClawbackVestingAccount(baseAcc, from, msg.StartTime.Unix(), lockupPeriods, vestingPeriods)
+
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, vestingAcc.OriginalVesting...); err != nil {
+		return nil, err
+	}
+
+	acc := k.accountKeeper.NewAccount(ctx, vestingAcc)
+	k.accountKeeper.SetAccount(ctx, acc)
+
+	if err := k.bankKeeper.SendCoins(ctx, from, to, vestingAcc.OriginalVesting); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCreateClawbackVestingAccount,
+				sdk.NewAttribute(types.AttributeKeyFunder, msg.FromAddress),
+				sdk.NewAttribute(types.AttributeKeyAccount, msg.ToAddress),
+				sdk.NewAttribute(types.AttributeKeyStartTime, strconv.FormatInt(vestingAcc.StartTime, 10)),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, vestingAcc.OriginalVesting.String()),
+			),
+		},
+	)
+
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+// Clawback removes the unvested tokens from a ClawbackVestingAccount and
+// transfers them to the destination address, which defaults to the funder.
+func (k Keeper) Clawback(
+	goCtx context.Context,
+	msg *types.MsgClawback,
+) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	addr, _ := sdk.AccAddressFromBech32(msg.AccountAddress)
+
+	dest, _ := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if msg.DestAddress != "" {
+		dest, _ = sdk.AccAddressFromBech32(msg.DestAddress)
+	}
+
+	if k.bankKeeper.BlockedAddr(dest) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", dest)
+	}
+
+	acc := k.accountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "account %s does not exist", msg.AccountAddress)
+	}
+
+	vestingAcc, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrNotClawbackAccount, "account %s", msg.AccountAddress)
+	}
+
+	if vestingAcc.FunderAddress != msg.FunderAddress {
+		return nil, sdkerrors.Wrapf(types.ErrFunderMismatch, "expected %s, got %s", vestingAcc.FunderAddress, msg.FunderAddress)
+	}
+
+	clawedBack, err := k.clawback(ctx, vestingAcc, dest)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeClawback,
+				sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+				sdk.NewAttribute(types.AttributeKeyAccount, msg.AccountAddress),
+				sdk.NewAttribute(types.AttributeKeyDestination, dest.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, clawedBack.String()),
+			),
+		},
+	)
+
+	return &types.MsgClawbackResponse{}, nil
+}
+
+// clawback truncates the vesting schedule of the account to the tokens vested
+// so far and transfers the unvested tokens held by the account to the
+// destination. Unvested tokens can't be delegated, so they are all part of
+// the account balance unless they were slashed.
+func (k Keeper) clawback(
+	ctx sdk.Context,
+	vestingAcc *types.ClawbackVestingAccount,
+	dest sdk.AccAddress,
+) (sdk.Coins, error) {
+	unvested := vestingAcc.ComputeClawback(ctx.BlockTime().Unix())
+	k.accountKeeper.SetAccount(ctx, vestingAcc)
+
+	// the unvested tokens are no longer locked once the schedule is truncated
+	spendable := k.bankKeeper.SpendableCoins(ctx, vestingAcc.GetAddress())
+	clawedBack := types.CoinsMin(unvested, spendable)
+
+	if clawedBack.IsZero() {
+		return clawedBack, nil
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, vestingAcc.GetAddress(), dest, clawedBack); err != nil {
+		return nil, err
+	}
+
+	return clawedBack, nil
+}
@@ -0,0 +1,280 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+var (
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+	_ authtypes.GenesisAccount    = (*ClawbackVestingAccount)(nil)
+)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount. The original
+// vesting is the sum of the vesting periods and the end time is the latest of
+// the lockup and vesting schedules end.
+func NewClawbackVestingAccount(
+	baseAcc *authtypes.BaseAccount,
+	funder sdk.AccAddress,
+	startTime int64,
+	lockupPeriods,
+	vestingPeriods sdkvesting.Periods,
+) *ClawbackVestingAccount {
+	lockupEnd, _ := periodsEndTime(startTime, lockupPeriods)
+	vestingEnd, originalVesting := periodsEndTime(startTime, vestingPeriods)
+
+	endTime := vestingEnd
+	if lockupEnd > endTime {
+		endTime = lockupEnd
+	}
+
+	baseVestingAcc := &sdkvesting.BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: originalVesting,
+		EndTime:         endTime,
+	}
+
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder.String(),
+		StartTime:          startTime,
+		LockupPeriods:      lockupPeriods,
+		VestingPeriods:     vestingPeriods,
+		CodeHash:           common.BytesToHash(evmtypes.EmptyCodeHash).Hex(),
+	}
+}
+
+// GetFunder returns the address of the funder of the vesting account.
+func (va ClawbackVestingAccount) GetFunder() sdk.AccAddress {
+	funder, _ := sdk.AccAddressFromBech32(va.FunderAddress)
+	return funder
+}
+
+// EthAddress returns the hex address of the vesting account.
+func (va ClawbackVestingAccount) EthAddress() common.Address {
+	return common.BytesToAddress(va.GetAddress().Bytes())
+}
+
+// GetCodeHash returns the code hash of the contract deployed at the account
+// address, or the empty code hash if the account is externally owned. A
+// contract can be deployed to the address of a vesting account that hasn't sent
+// any transaction, e.g. with CREATE2.
+func (va ClawbackVestingAccount) GetCodeHash() common.Hash {
+	if va.CodeHash == "" {
+		return common.BytesToHash(evmtypes.EmptyCodeHash)
+	}
+	return common.HexToHash(va.CodeHash)
+}
+
+// SetCodeHash sets the code hash of the contract deployed at the account
+// address.
+func (va *ClawbackVestingAccount) SetCodeHash(codeHash common.Hash) {
+	va.CodeHash = codeHash.Hex()
+}
+
+// GetVestedCoins returns the total number of vested coins that are also
+// unlocked. If no coins are vested, nil is returned.
+func (va ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	coins := CoinsMin(va.GetUnlockedOnly(blockTime), va.GetVestedOnly(blockTime))
+	if coins.IsZero() {
+		return nil
+	}
+	return coins
+}
+
+// GetVestingCoins returns the total number of coins that are either unvested
+// or still locked. If no coins are vesting, nil is returned.
+func (va ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return va.OriginalVesting.Sub(va.GetVestedCoins(blockTime))
+}
+
+// GetUnlockedOnly returns the coins released by the lockup schedule only,
+// regardless of the vesting schedule.
+func (va ClawbackVestingAccount) GetUnlockedOnly(blockTime time.Time) sdk.Coins {
+	return readSchedule(va.StartTime, va.EndTime, va.LockupPeriods, va.OriginalVesting, blockTime.Unix())
+}
+
+// GetVestedOnly returns the coins vested by the vesting schedule only,
+// regardless of the lockup schedule.
+func (va ClawbackVestingAccount) GetVestedOnly(blockTime time.Time) sdk.Coins {
+	return readSchedule(va.StartTime, va.EndTime, va.VestingPeriods, va.OriginalVesting, blockTime.Unix())
+}
+
+// GetUnvestedOnly returns the coins that can still be clawed back by the
+// funder.
+func (va ClawbackVestingAccount) GetUnvestedOnly(blockTime time.Time) sdk.Coins {
+	return va.OriginalVesting.Sub(va.GetVestedOnly(blockTime))
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (va ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return va.BaseVestingAccount.LockedCoinsFromVesting(va.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (va *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	va.BaseVestingAccount.TrackDelegation(balance, va.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a clawback vesting
+// account.
+func (va ClawbackVestingAccount) GetStartTime() int64 {
+	return va.StartTime
+}
+
+// Validate checks for errors on the account fields
+func (va ClawbackVestingAccount) Validate() error {
+	if va.GetStartTime() > va.GetEndTime() {
+		return errors.New("vesting start-time cannot be after end-time")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(va.FunderAddress); err != nil {
+		return fmt.Errorf("invalid funder address: %w", err)
+	}
+
+	if err := validatePeriods(va.LockupPeriods); err != nil {
+		return fmt.Errorf("invalid lockup periods: %w", err)
+	}
+
+	if err := validatePeriods(va.VestingPeriods); err != nil {
+		return fmt.Errorf("invalid vesting periods: %w", err)
+	}
+
+	lockupEnd, lockupCoins := periodsEndTime(va.StartTime, va.LockupPeriods)
+	vestingEnd, vestingCoins := periodsEndTime(va.StartTime, va.VestingPeriods)
+
+	if lockupEnd > va.EndTime || vestingEnd > va.EndTime {
+		return errors.New("vesting end time is before the end of the lockup or vesting periods")
+	}
+
+	if !lockupCoins.IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in lockup periods")
+	}
+
+	if !vestingCoins.IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
+	}
+
+	return va.BaseVestingAccount.Validate()
+}
+
+// ComputeClawback removes all the future vesting events from the account and
+// returns their total amount. The lockup schedule is capped to the remaining
+// vested amount so that both schedules keep describing the same total.
+func (va *ClawbackVestingAccount) ComputeClawback(clawbackTime int64) sdk.Coins {
+	vestTime := va.StartTime
+	totalVested := sdk.NewCoins()
+	totalUnvested := sdk.NewCoins()
+	unvestedIdx := 0
+
+	for i, period := range va.VestingPeriods {
+		vestTime += period.Length
+		// a tie in time goes to the vesting account
+		if vestTime <= clawbackTime {
+			totalVested = totalVested.Add(period.Amount...)
+			unvestedIdx = i + 1
+		} else {
+			totalUnvested = totalUnvested.Add(period.Amount...)
+		}
+	}
+
+	newVestingPeriods := va.VestingPeriods[:unvestedIdx]
+	vestingEnd, _ := periodsEndTime(va.StartTime, newVestingPeriods)
+
+	// cap the lockup schedule to the new total vested, dropping the periods
+	// that are left empty
+	newLockupPeriods := sdkvesting.Periods{}
+	remaining := totalVested
+	for _, period := range va.LockupPeriods {
+		amount := CoinsMin(period.Amount, remaining)
+		remaining = remaining.Sub(amount)
+		newLockupPeriods = append(newLockupPeriods, sdkvesting.Period{Length: period.Length, Amount: amount})
+	}
+
+	for len(newLockupPeriods) > 0 && newLockupPeriods[len(newLockupPeriods)-1].Amount.IsZero() {
+		newLockupPeriods = newLockupPeriods[:len(newLockupPeriods)-1]
+	}
+
+	lockupEnd, _ := periodsEndTime(va.StartTime, newLockupPeriods)
+
+	endTime := vestingEnd
+	if lockupEnd > endTime {
+		endTime = lockupEnd
+	}
+
+	va.OriginalVesting = totalVested
+	va.EndTime = endTime
+	va.LockupPeriods = newLockupPeriods
+	va.VestingPeriods = newVestingPeriods
+
+	return totalUnvested
+}
+
+type clawbackVestingAccountYAML struct {
+	Address          sdk.AccAddress     `json:"address" yaml:"address"`
+	PubKey           string             `json:"public_key" yaml:"public_key"`
+	AccountNumber    uint64             `json:"account_number" yaml:"account_number"`
+	Sequence         uint64             `json:"sequence" yaml:"sequence"`
+	OriginalVesting  sdk.Coins          `json:"original_vesting" yaml:"original_vesting"`
+	DelegatedFree    sdk.Coins          `json:"delegated_free" yaml:"delegated_free"`
+	DelegatedVesting sdk.Coins          `json:"delegated_vesting" yaml:"delegated_vesting"`
+	EndTime          int64              `json:"end_time" yaml:"end_time"`
+	FunderAddress    string             `json:"funder_address" yaml:"funder_address"`
+	StartTime        int64              `json:"start_time" yaml:"start_time"`
+	LockupPeriods    sdkvesting.Periods `json:"lockup_periods" yaml:"lockup_periods"`
+	VestingPeriods   sdkvesting.Periods `json:"vesting_periods" yaml:"vesting_periods"`
+	CodeHash         string             `json:"code_hash" yaml:"code_hash"`
+}
+
+func (va ClawbackVestingAccount) String() string {
+	out, _ := va.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ClawbackVestingAccount.
+func (va ClawbackVestingAccount) MarshalYAML() (interface{}, error) {
+	accAddr, err := sdk.AccAddressFromBech32(va.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	out := clawbackVestingAccountYAML{
+		Address:          accAddr,
+		AccountNumber:    va.AccountNumber,
+		Sequence:         va.Sequence,
+		OriginalVesting:  va.OriginalVesting,
+		DelegatedFree:    va.DelegatedFree,
+		DelegatedVesting: va.DelegatedVesting,
+		EndTime:          va.EndTime,
+		FunderAddress:    va.FunderAddress,
+		StartTime:        va.StartTime,
+		LockupPeriods:    va.LockupPeriods,
+		VestingPeriods:   va.VestingPeriods,
+		CodeHash:         va.CodeHash,
+	}
+
+	if pk := va.GetPubKey(); pk != nil {
+		out.PubKey = pk.String()
+	}
+
+	bz, err := yaml.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(bz), nil
+}
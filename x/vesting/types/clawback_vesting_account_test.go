@@ -0,0 +1,115 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+func coins(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("aphoton", amount))
+}
+
+func newTestAccount(lockupPeriods, vestingPeriods sdkvesting.Periods) *ClawbackVestingAccount {
+	addr := sdk.AccAddress(common.HexToAddress("0x01").Bytes())
+	funder := sdk.AccAddress(common.HexToAddress("0x02").Bytes())
+	baseAcc := authtypes.NewBaseAccountWithAddress(addr)
+	return NewClawbackVestingAccount(baseAcc, funder, 1000, lockupPeriods, vestingPeriods)
+}
+
+func TestClawbackVestingAccountSchedules(t *testing.T) {
+	// unlocked all at once after 300s, vested by quarters every 100s
+	lockup := sdkvesting.Periods{{Length: 300, Amount: coins(400)}}
+	vesting := sdkvesting.Periods{
+		{Length: 100, Amount: coins(100)},
+		{Length: 100, Amount: coins(100)},
+		{Length: 100, Amount: coins(100)},
+		{Length: 100, Amount: coins(100)},
+	}
+	va := newTestAccount(lockup, vesting)
+
+	require.NoError(t, va.Validate())
+	require.Equal(t, int64(1400), va.GetEndTime())
+	require.Equal(t, coins(400), va.OriginalVesting)
+	require.Equal(t, common.BytesToHash(evmtypes.EmptyCodeHash), va.GetCodeHash())
+	require.Equal(t, common.HexToAddress("0x01"), va.EthAddress())
+
+	testCases := []struct {
+		time     int64
+		unlocked int64
+		vested   int64
+		locked   int64
+	}{
+		{1000, 0, 0, 400},
+		{1150, 0, 100, 400},
+		{1300, 400, 300, 100},
+		{1400, 400, 400, 0},
+	}
+
+	for _, tc := range testCases {
+		blockTime := time.Unix(tc.time, 0)
+		require.Equal(t, coins(tc.unlocked), va.GetUnlockedOnly(blockTime), "unlocked at %d", tc.time)
+		require.Equal(t, coins(tc.vested), va.GetVestedOnly(blockTime), "vested at %d", tc.time)
+		require.Equal(t, coins(tc.locked), va.LockedCoins(blockTime), "locked at %d", tc.time)
+	}
+}
+
+func TestClawbackVestingAccountValidate(t *testing.T) {
+	lockup := sdkvesting.Periods{{Length: 300, Amount: coins(400)}}
+	vesting := sdkvesting.Periods{{Length: 100, Amount: coins(300)}}
+	require.Error(t, newTestAccount(lockup, vesting).Validate(), "mismatched totals")
+
+	va := newTestAccount(lockup, sdkvesting.Periods{{Length: 100, Amount: coins(400)}})
+	va.FunderAddress = ""
+	require.Error(t, va.Validate(), "invalid funder")
+
+	va = newTestAccount(lockup, sdkvesting.Periods{{Length: 100, Amount: coins(400)}})
+	va.EndTime = 1200
+	require.Error(t, va.Validate(), "end time before the end of the lockup")
+}
+
+func TestComputeClawback(t *testing.T) {
+	lockup := sdkvesting.Periods{
+		{Length: 150, Amount: coins(200)},
+		{Length: 150, Amount: coins(200)},
+	}
+	vesting := sdkvesting.Periods{
+		{Length: 100, Amount: coins(100)},
+		{Length: 100, Amount: coins(100)},
+		{Length: 100, Amount: coins(100)},
+		{Length: 100, Amount: coins(100)},
+	}
+	va := newTestAccount(lockup, vesting)
+
+	unvested := va.ComputeClawback(1200)
+	require.Equal(t, coins(200), unvested)
+	require.Equal(t, coins(200), va.OriginalVesting)
+	require.Equal(t, vesting[:2], va.VestingPeriods)
+	require.Equal(t, sdkvesting.Periods{{Length: 150, Amount: coins(200)}}, va.LockupPeriods)
+	require.Equal(t, int64(1200), va.GetEndTime())
+	require.NoError(t, va.Validate())
+
+	// the remaining vested tokens are still locked until the lockup ends
+	require.Equal(t, coins(200), va.LockedCoins(time.Unix(1100, 0)))
+	require.Equal(t, coins(0), va.LockedCoins(time.Unix(1200, 0)))
+}
+
+func TestDefaultSchedules(t *testing.T) {
+	vesting := sdkvesting.Periods{{Length: 100, Amount: coins(400)}}
+
+	lockup, vestingRes := DefaultSchedules(nil, vesting)
+	require.Equal(t, sdkvesting.Periods{{Length: 0, Amount: coins(400)}}, lockup)
+	require.Equal(t, vesting, vestingRes)
+
+	lockupRes, vestingDefault := DefaultSchedules(vesting, nil)
+	require.Equal(t, vesting, lockupRes)
+	require.Equal(t, sdkvesting.Periods{{Length: 0, Amount: coins(400)}}, vestingDefault)
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global vesting module codec. Note, the codec
+	// should ONLY be used in certain instances of tests and for JSON encoding.
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// AminoCdc is a amino codec created to support amino JSON compatible msgs.
+	AminoCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
+// RegisterInterfaces associates the ClawbackVestingAccount with the AccountI,
+// GenesisAccount and VestingAccount interfaces and registers the vesting
+// messages. The SDK vesting account types are registered as well so that the
+// existing accounts can still be decoded.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterInterface(
+		"cosmos.vesting.v1beta1.VestingAccount",
+		(*vestexported.VestingAccount)(nil),
+		&sdkvesting.ContinuousVestingAccount{},
+		&sdkvesting.DelayedVestingAccount{},
+		&sdkvesting.PeriodicVestingAccount{},
+		&sdkvesting.PermanentLockedAccount{},
+		&ClawbackVestingAccount{},
+	)
+
+	registry.RegisterImplementations(
+		(*authtypes.AccountI)(nil),
+		&sdkvesting.BaseVestingAccount{},
+		&sdkvesting.DelayedVestingAccount{},
+		&sdkvesting.ContinuousVestingAccount{},
+		&sdkvesting.PeriodicVestingAccount{},
+		&sdkvesting.PermanentLockedAccount{},
+		&ClawbackVestingAccount{},
+	)
+
+	registry.RegisterImplementations(
+		(*authtypes.GenesisAccount)(nil),
+		&sdkvesting.BaseVestingAccount{},
+		&sdkvesting.DelayedVestingAccount{},
+		&sdkvesting.ContinuousVestingAccount{},
+		&sdkvesting.PeriodicVestingAccount{},
+		&sdkvesting.PermanentLockedAccount{},
+		&ClawbackVestingAccount{},
+	)
+
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgCreateClawbackVestingAccount{},
+		&MsgClawback{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// RegisterLegacyAminoCodec registers the necessary x/vesting interfaces and
+// concrete types on the provided LegacyAmino codec. These types are used for
+// Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&ClawbackVestingAccount{}, "evmos/ClawbackVestingAccount", nil)
+	cdc.RegisterConcrete(&MsgCreateClawbackVestingAccount{}, "evmos/MsgCreateClawbackVestingAccount", nil)
+	cdc.RegisterConcrete(&MsgClawback{}, "evmos/MsgClawback", nil)
+}
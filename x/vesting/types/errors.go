@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// errors
+var (
+	ErrInvalidVestingSchedule = sdkerrors.Register(ModuleName, 2, "invalid vesting schedule")
+	ErrNotClawbackAccount     = sdkerrors.Register(ModuleName, 3, "account is not a clawback vesting account")
+	ErrFunderMismatch         = sdkerrors.Register(ModuleName, 4, "funder does not match the vesting account funder")
+	ErrVestingDelegation      = sdkerrors.Register(ModuleName, 5, "cannot delegate unvested coins")
+)
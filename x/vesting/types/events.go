@@ -0,0 +1,12 @@
+package types
+
+// vesting events
+const (
+	EventTypeCreateClawbackVestingAccount = "create_clawback_vesting_account"
+	EventTypeClawback                     = "clawback"
+
+	AttributeKeyFunder      = "funder"
+	AttributeKeyAccount     = "account"
+	AttributeKeyDestination = "destination"
+	AttributeKeyStartTime   = "start_time"
+)
@@ -0,0 +1,152 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateClawbackVestingAccount{}
+	_ sdk.Msg = &MsgClawback{}
+)
+
+const (
+	TypeMsgCreateClawbackVestingAccount = "create_clawback_vesting_account"
+	TypeMsgClawback                     = "clawback"
+)
+
+// NewMsgCreateClawbackVestingAccount creates a new instance of
+// MsgCreateClawbackVestingAccount
+func NewMsgCreateClawbackVestingAccount( // nolint: interfacer
+	funder, account sdk.AccAddress,
+	startTime time.Time,
+	lockupPeriods,
+	vestingPeriods sdkvesting.Periods,
+) *MsgCreateClawbackVestingAccount {
+	return &MsgCreateClawbackVestingAccount{
+		FromAddress:    funder.String(),
+		ToAddress:      account.String(),
+		StartTime:      startTime,
+		LockupPeriods:  lockupPeriods,
+		VestingPeriods: vestingPeriods,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgCreateClawbackVestingAccount) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCreateClawbackVestingAccount) Type() string {
+	return TypeMsgCreateClawbackVestingAccount
+}
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCreateClawbackVestingAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid funder address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid account address")
+	}
+
+	if len(msg.LockupPeriods) == 0 && len(msg.VestingPeriods) == 0 {
+		return sdkerrors.Wrap(ErrInvalidVestingSchedule, "lockup and vesting periods cannot be both empty")
+	}
+
+	if err := validatePeriods(msg.LockupPeriods); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidVestingSchedule, "lockup periods: %s", err)
+	}
+
+	if err := validatePeriods(msg.VestingPeriods); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidVestingSchedule, "vesting periods: %s", err)
+	}
+
+	_, lockupCoins := periodsEndTime(0, msg.LockupPeriods)
+	_, vestingCoins := periodsEndTime(0, msg.VestingPeriods)
+
+	if len(msg.LockupPeriods) != 0 && len(msg.VestingPeriods) != 0 && !lockupCoins.IsEqual(vestingCoins) {
+		return sdkerrors.Wrapf(
+			ErrInvalidVestingSchedule,
+			"lockup (%s) and vesting (%s) amounts must be equal", lockupCoins, vestingCoins,
+		)
+	}
+
+	if lockupCoins.IsZero() && vestingCoins.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "vesting amount must be positive")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgCreateClawbackVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCreateClawbackVestingAccount) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil
+	}
+
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgClawback creates a new instance of MsgClawback
+func NewMsgClawback(funder, account, dest sdk.AccAddress) *MsgClawback { // nolint: interfacer
+	var destAddress string
+	if dest != nil {
+		destAddress = dest.String()
+	}
+
+	return &MsgClawback{
+		FunderAddress:  funder.String(),
+		AccountAddress: account.String(),
+		DestAddress:    destAddress,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgClawback) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgClawback) Type() string { return TypeMsgClawback }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgClawback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid funder address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.AccountAddress); err != nil {
+		return sdkerrors.Wrap(err, "invalid account address")
+	}
+
+	if msg.DestAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.DestAddress); err != nil {
+			return sdkerrors.Wrap(err, "invalid destination address")
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		return nil
+	}
+
+	return []sdk.AccAddress{addr}
+}
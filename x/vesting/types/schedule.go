@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// readSchedule returns the amount released by the given schedule at the
+// given time. A period is released once its whole length has elapsed.
+func readSchedule(startTime, endTime int64, periods sdkvesting.Periods, totalCoins sdk.Coins, readTime int64) sdk.Coins {
+	if readTime <= startTime {
+		return sdk.NewCoins()
+	}
+
+	if readTime >= endTime {
+		return totalCoins
+	}
+
+	coins := sdk.NewCoins()
+	time := startTime
+
+	for _, period := range periods {
+		if readTime < time+period.Length {
+			break
+		}
+
+		coins = coins.Add(period.Amount...)
+		time += period.Length
+	}
+
+	return coins
+}
+
+// DefaultSchedules returns the given lockup and vesting schedules, where an
+// empty schedule defaults to an instant release of the other schedule total.
+func DefaultSchedules(lockupPeriods, vestingPeriods sdkvesting.Periods) (sdkvesting.Periods, sdkvesting.Periods) {
+	switch {
+	case len(lockupPeriods) == 0:
+		_, total := periodsEndTime(0, vestingPeriods)
+		lockupPeriods = sdkvesting.Periods{{Length: 0, Amount: total}}
+	case len(vestingPeriods) == 0:
+		_, total := periodsEndTime(0, lockupPeriods)
+		vestingPeriods = sdkvesting.Periods{{Length: 0, Amount: total}}
+	}
+
+	return lockupPeriods, vestingPeriods
+}
+
+// periodsEndTime returns the end time and the total amount of the given
+// schedule.
+func periodsEndTime(startTime int64, periods sdkvesting.Periods) (int64, sdk.Coins) {
+	endTime := startTime
+	total := sdk.NewCoins()
+
+	for _, period := range periods {
+		endTime += period.Length
+		total = total.Add(period.Amount...)
+	}
+
+	return endTime, total
+}
+
+// validatePeriods checks that the periods have non-negative lengths and valid
+// amounts.
+func validatePeriods(periods sdkvesting.Periods) error {
+	for i, period := range periods {
+		if period.Length < 0 {
+			return fmt.Errorf("period #%d has a negative length: %d", i, period.Length)
+		}
+
+		if err := period.Amount.Validate(); err != nil {
+			return fmt.Errorf("period #%d has an invalid amount: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// CoinsMin returns the minimum amount of each denomination of the given coins.
+func CoinsMin(a, b sdk.Coins) sdk.Coins {
+	min := sdk.NewCoins()
+
+	for _, coin := range a {
+		amount := sdk.MinInt(coin.Amount, b.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			min = min.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	return min
+}